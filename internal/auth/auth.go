@@ -3,7 +3,7 @@ package auth
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -18,42 +18,104 @@ import (
 
 const (
 	credentialsFile = "google_credentials.json"
-	tokenFile       = "youtube_token.json"
+	tokenName       = "youtube_token"
+
+	// APIKeyEnv provides an API key for public read commands.
+	APIKeyEnv = "YOUTUBE_API_KEY"
+
+	// ServiceAccountEnv points to service account credentials for public
+	// read commands.
+	ServiceAccountEnv = "GOOGLE_APPLICATION_CREDENTIALS"
 )
 
-var scopes = []string{
-	youtube.YoutubeReadonlyScope,
-	youtube.YoutubeForceSslScope,
+// ErrPublicOnly is returned when a command that needs a user's OAuth token is
+// run with only an API key or service account available.
+var ErrPublicOnly = errors.New("API keys and service accounts can only be used for public read commands")
+
+// Options configures how a Client authenticates.
+type Options struct {
+	// Profile selects the named identity to use. When empty, the
+	// YTM_PROFILE environment variable or the configured default is used.
+	Profile string
+	// Scopes is the scope set the command needs. It defaults to Manage.
+	Scopes ScopeSet
+	// DeviceFlow uses the OAuth device authorization grant instead of a
+	// browser redirect, for headless machines.
+	DeviceFlow bool
+	// APIKey authenticates public read commands without OAuth. When empty,
+	// the YOUTUBE_API_KEY environment variable is used.
+	APIKey string
+	// NewProfile allows a Profile that is not registered yet, so that it can
+	// be signed in before it is added to the configuration.
+	NewProfile bool
 }
 
 // Client manages YouTube API authentication and provides authenticated clients.
 type Client struct {
+	profile         string
 	credentialsPath string
-	tokenPath       string
+	scopes          ScopeSet
+	store           TokenStore
+	tokenName       string
+	deviceFlow      bool
+	apiKey          string
+	serviceAccount  string
 }
 
-// NewClient creates a new auth client with default credentials paths.
-func NewClient() (*Client, error) {
-	home, err := os.UserHomeDir()
+// NewClient creates a new auth client for the selected profile. All profiles
+// share the OAuth client credentials but keep separate tokens in the
+// configured token store.
+func NewClient(opts Options) (*Client, error) {
+	credDir, err := credentialsDir()
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := config.resolveProfile(opts.Profile, opts.NewProfile)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user home directory: %w", err)
+		return nil, err
+	}
+
+	store, err := NewTokenStore(config.StoreBackend(), credDir)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := opts.Scopes
+	if scopes.Name == "" {
+		scopes = Manage
+	}
+
+	apiKey := opts.APIKey
+	if apiKey == "" {
+		apiKey = os.Getenv(APIKeyEnv)
 	}
 
-	credDir := filepath.Join(home, ".credentials")
 	return &Client{
+		profile:         profile,
 		credentialsPath: filepath.Join(credDir, credentialsFile),
-		tokenPath:       filepath.Join(credDir, tokenFile),
+		scopes:          scopes,
+		store:           store,
+		tokenName:       scopes.tokenName(profile),
+		deviceFlow:      opts.DeviceFlow,
+		apiKey:          apiKey,
+		serviceAccount:  os.Getenv(ServiceAccountEnv),
 	}, nil
 }
 
 // GetYouTubeService returns an authenticated YouTube service.
 func (c *Client) GetYouTubeService(ctx context.Context) (*youtube.Service, error) {
-	httpClient, err := c.getHTTPClient(ctx)
+	opts, err := c.clientOptions(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	service, err := youtube.NewService(ctx, option.WithHTTPClient(httpClient))
+	service, err := youtube.NewService(ctx, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create YouTube service: %w", err)
 	}
@@ -61,21 +123,106 @@ func (c *Client) GetYouTubeService(ctx context.Context) (*youtube.Service, error
 	return service, nil
 }
 
-// getHTTPClient returns an authenticated HTTP client.
-func (c *Client) getHTTPClient(ctx context.Context) (*http.Client, error) {
+// clientOptions picks how to authenticate API calls. Commands reading public
+// data use the API key if one is set, or a service account when the profile
+// has no saved user token. Other commands use the user's OAuth token, signing
+// in if needed; they fail when only an API key is available.
+func (c *Client) clientOptions(ctx context.Context) ([]option.ClientOption, error) {
+	if c.apiKey != "" {
+		if c.scopes.public {
+			return []option.ClientOption{option.WithAPIKey(c.apiKey)}, nil
+		}
+		if !c.HasToken() {
+			return nil, fmt.Errorf("%w: this command needs OAuth sign-in, run 'youtube-manager auth login' or unset %s and drop --api-key", ErrPublicOnly, APIKeyEnv)
+		}
+	}
+
+	if c.serviceAccount != "" && !c.HasToken() {
+		if !c.scopes.public {
+			return nil, fmt.Errorf("%w: this command needs OAuth sign-in, run 'youtube-manager auth login' or unset %s", ErrPublicOnly, ServiceAccountEnv)
+		}
+		return []option.ClientOption{
+			option.WithCredentialsFile(c.serviceAccount),
+			option.WithScopes(c.scopes.Scopes...),
+		}, nil
+	}
+
+	httpClient, err := c.getHTTPClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return []option.ClientOption{option.WithHTTPClient(httpClient)}, nil
+}
+
+// Profile returns the name of the profile this client authenticates as.
+func (c *Client) Profile() string {
+	return c.profile
+}
+
+// HasToken reports whether any token is saved for the profile.
+func (c *Client) HasToken() bool {
+	for _, name := range profileTokenNames(c.profile) {
+		if _, err := c.store.Load(name); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Login forces a fresh interactive login for the client's scope set and
+// saves the resulting token.
+func (c *Client) Login(ctx context.Context) error {
+	config, err := c.oauthConfig()
+	if err != nil {
+		return err
+	}
+
+	token, err := c.authenticate(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	return c.saveToken(token)
+}
+
+// RemoveToken deletes every saved token of the profile.
+func (c *Client) RemoveToken() error {
+	for _, name := range profileTokenNames(c.profile) {
+		if err := c.store.Delete(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// oauthConfig loads the OAuth client configuration from the credentials file.
+func (c *Client) oauthConfig() (*oauth2.Config, error) {
 	credentials, err := os.ReadFile(c.credentialsPath)
 	if err != nil {
 		return nil, fmt.Errorf("unable to read credentials file %s: %w\nSee README.md for setup instructions", c.credentialsPath, err)
 	}
 
-	config, err := google.ConfigFromJSON(credentials, scopes...)
+	config, err := google.ConfigFromJSON(credentials, c.scopes.Scopes...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse credentials: %w", err)
 	}
 
-	token, err := c.tokenFromFile()
+	return config, nil
+}
+
+// getHTTPClient returns an authenticated HTTP client.
+func (c *Client) getHTTPClient(ctx context.Context) (*http.Client, error) {
+	config, err := c.oauthConfig()
 	if err != nil {
-		token, err = c.getTokenFromWeb(config)
+		return nil, err
+	}
+
+	token, err := c.loadToken()
+	if errors.Is(err, ErrTokenNotFound) {
+		if c.HasToken() {
+			fmt.Fprintf(os.Stderr, "🔐 This command needs additional permissions (%s access).\n", c.scopes.Name)
+		}
+		token, err = c.authenticate(ctx, config)
 		if err != nil {
 			return nil, err
 		}
@@ -83,62 +230,66 @@ func (c *Client) getHTTPClient(ctx context.Context) (*http.Client, error) {
 			slog.Warn("Unable to save token", "error", err)
 		}
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	return config.Client(ctx, token), nil
+	return oauth2.NewClient(ctx, newPersistingTokenSource(ctx, c, config, token)), nil
 }
 
-// getTokenFromWeb initiates OAuth flow and returns a token.
-func (c *Client) getTokenFromWeb(config *oauth2.Config) (*oauth2.Token, error) {
-	authURL := config.AuthCodeURL("state-token", oauth2.AccessTypeOffline)
-	fmt.Printf("Go to the following link in your browser:\n%v\n\n", authURL)
-	fmt.Printf("Enter authorization code: ")
-
-	var authCode string
-	if _, err := fmt.Scan(&authCode); err != nil {
-		return nil, fmt.Errorf("unable to read authorization code: %w", err)
-	}
-
-	token, err := config.Exchange(context.Background(), authCode)
+// authenticate obtains a new token for the client's scope set and directs
+// later saves to that scope set's token name.
+func (c *Client) authenticate(ctx context.Context, config *oauth2.Config) (*oauth2.Token, error) {
+	token, err := c.login(ctx, config)
 	if err != nil {
-		return nil, fmt.Errorf("unable to retrieve token from web: %w", err)
+		return nil, err
 	}
 
+	c.tokenName = storedScopeSet(c.scopes, token).tokenName(c.profile)
 	return token, nil
 }
 
-// tokenFromFile loads a token from the token file.
-func (c *Client) tokenFromFile() (*oauth2.Token, error) {
-	file, err := os.Open(c.tokenPath)
-	if err != nil {
-		return nil, err
+// login obtains a new token interactively, using the device flow when
+// requested and the browser flow otherwise.
+func (c *Client) login(ctx context.Context, config *oauth2.Config) (*oauth2.Token, error) {
+	if c.deviceFlow {
+		return newDeviceFlow(config).Token(ctx)
 	}
-	defer file.Close()
+	return c.getTokenFromWeb(ctx, config)
+}
 
-	token := &oauth2.Token{}
-	if err := json.NewDecoder(file).Decode(token); err != nil {
-		return nil, err
+// getTokenFromWeb runs the browser-based OAuth flow and returns a token.
+func (c *Client) getTokenFromWeb(ctx context.Context, config *oauth2.Config) (*oauth2.Token, error) {
+	flow := newLoopbackFlow(config)
+	flow.includeGrantedScopes = c.scopes.Name == Manage.Name
+	token, err := flow.Token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve token from web: %w", err)
 	}
 
 	return token, nil
 }
 
-// saveToken saves a token to the token file.
-func (c *Client) saveToken(token *oauth2.Token) error {
-	slog.Info("Saving credentials", "path", c.tokenPath)
-
-	if err := os.MkdirAll(filepath.Dir(c.tokenPath), 0700); err != nil {
-		return fmt.Errorf("failed to create credentials directory: %w", err)
-	}
+// loadToken loads the narrowest saved token that satisfies the client's
+// scope set, and remembers its name so refreshed tokens are saved back to it.
+func (c *Client) loadToken() (*oauth2.Token, error) {
+	for _, name := range c.scopes.candidateTokenNames(c.profile) {
+		token, err := c.store.Load(name)
+		if errors.Is(err, ErrTokenNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
 
-	file, err := os.OpenFile(c.tokenPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
-	if err != nil {
-		return fmt.Errorf("failed to create token file: %w", err)
+		c.tokenName = name
+		return token, nil
 	}
-	defer file.Close()
 
-	if err := json.NewEncoder(file).Encode(token); err != nil {
-		return fmt.Errorf("failed to encode token: %w", err)
-	}
+	return nil, ErrTokenNotFound
+}
 
-	return nil
+// saveToken saves the profile's token to the token store.
+func (c *Client) saveToken(token *oauth2.Token) error {
+	return c.store.Save(c.tokenName, token)
 }
@@ -0,0 +1,212 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	configFile = "youtube_manager.json"
+
+	// DefaultProfile is the profile used when none is selected. Its token
+	// lives in the historical youtube_token.json file.
+	DefaultProfile = "default"
+
+	// ProfileEnv selects a profile from the environment.
+	ProfileEnv = "YTM_PROFILE"
+)
+
+var profileNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+// Config is the persistent youtube-manager configuration.
+type Config struct {
+	DefaultProfile string   `json:"default_profile,omitempty"`
+	Profiles       []string `json:"profiles,omitempty"`
+	TokenStore     string   `json:"token_store,omitempty"`
+
+	path string
+}
+
+// credentialsDir returns the directory holding credentials, tokens and config.
+func credentialsDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+
+	return filepath.Join(home, ".credentials"), nil
+}
+
+// LoadConfig reads the configuration file. A missing file yields an empty
+// configuration.
+func LoadConfig() (*Config, error) {
+	dir, err := credentialsDir()
+	if err != nil {
+		return nil, err
+	}
+
+	config := &Config{path: filepath.Join(dir, configFile)}
+	data, err := os.ReadFile(config.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return config, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read config file %s: %w", config.path, err)
+	}
+
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("unable to parse config file %s: %w", config.path, err)
+	}
+
+	return config, nil
+}
+
+// Save writes the configuration file.
+func (c *Config) Save() error {
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return fmt.Errorf("failed to create credentials directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+
+	if err := os.WriteFile(c.path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+
+	return nil
+}
+
+// ActiveProfile returns the configured default profile.
+func (c *Config) ActiveProfile() string {
+	if c.DefaultProfile == "" {
+		return DefaultProfile
+	}
+	return c.DefaultProfile
+}
+
+// StoreBackend returns the token store backend to use: YTM_TOKEN_STORE if
+// set, then the configured backend, then plain files.
+func (c *Config) StoreBackend() string {
+	if backend := os.Getenv(TokenStoreEnv); backend != "" {
+		return backend
+	}
+	if c.TokenStore != "" {
+		return c.TokenStore
+	}
+	return StoreFile
+}
+
+// ProfileNames returns every known profile, including the default one.
+func (c *Config) ProfileNames() []string {
+	names := []string{DefaultProfile}
+	for _, name := range c.Profiles {
+		if name != DefaultProfile {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names[1:])
+	return names
+}
+
+// HasProfile reports whether name is a known profile.
+func (c *Config) HasProfile(name string) bool {
+	for _, known := range c.ProfileNames() {
+		if known == name {
+			return true
+		}
+	}
+	return false
+}
+
+// AddProfile registers a profile.
+func (c *Config) AddProfile(name string) error {
+	if err := ValidateProfileName(name); err != nil {
+		return err
+	}
+	if !c.HasProfile(name) {
+		c.Profiles = append(c.Profiles, name)
+	}
+	return nil
+}
+
+// RemoveProfile unregisters a profile, falling back to the default profile if
+// it was selected.
+func (c *Config) RemoveProfile(name string) {
+	profiles := c.Profiles[:0]
+	for _, known := range c.Profiles {
+		if known != name {
+			profiles = append(profiles, known)
+		}
+	}
+	c.Profiles = profiles
+
+	if c.DefaultProfile == name {
+		c.DefaultProfile = ""
+	}
+}
+
+// ValidateProfileName checks that name is usable in a token file name. Names
+// ending in a scope set suffix, such as "readonly" or "work_readonly", are
+// reserved: their tokens would share a name with another profile's
+// narrower tokens.
+func ValidateProfileName(name string) error {
+	if !profileNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q: use letters, digits, '-' and '_'", name)
+	}
+	for _, set := range scopeSets {
+		suffix := strings.TrimPrefix(set.tokenName(DefaultProfile), tokenName+"_")
+		if set.Name != Manage.Name && (name == suffix || strings.HasSuffix(name, "_"+suffix)) {
+			return fmt.Errorf("invalid profile name %q: names ending in %q are reserved", name, suffix)
+		}
+	}
+	return nil
+}
+
+// ResolveProfile picks the profile to use: an explicit name first, then the
+// YTM_PROFILE environment variable, then the configured default. The profile
+// must have been added with 'auth add'.
+func ResolveProfile(explicit string) (string, error) {
+	config, err := LoadConfig()
+	if err != nil {
+		return "", err
+	}
+	return config.resolveProfile(explicit, false)
+}
+
+// resolveProfile implements ResolveProfile. allowNew accepts a profile that is
+// not registered yet, for the client that signs it in.
+func (c *Config) resolveProfile(explicit string, allowNew bool) (string, error) {
+	name := explicit
+	if name == "" {
+		name = os.Getenv(ProfileEnv)
+	}
+	if name == "" {
+		name = c.ActiveProfile()
+	}
+
+	if err := ValidateProfileName(name); err != nil {
+		return "", err
+	}
+	if !allowNew && !c.HasProfile(name) {
+		return "", fmt.Errorf("unknown profile: %s (add it with 'auth add %s')", name, name)
+	}
+	return name, nil
+}
+
+// profileTokenName returns the base name a profile's tokens are stored
+// under. The default profile keeps the historical youtube_token name.
+func profileTokenName(profile string) string {
+	if profile == DefaultProfile {
+		return tokenName
+	}
+	return fmt.Sprintf("%s_%s", tokenName, profile)
+}
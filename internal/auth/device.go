@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/youtube/v3"
+)
+
+// deviceFlow runs the OAuth 2.0 device authorization grant (RFC 8628) for
+// machines where neither a browser nor a loopback redirect is available.
+type deviceFlow struct {
+	config *oauth2.Config
+}
+
+// newDeviceFlow creates a device flow. Credentials files downloaded from the
+// Cloud Console do not carry a device endpoint, so Google's is used by default.
+func newDeviceFlow(config *oauth2.Config) *deviceFlow {
+	cfg := *config
+	if cfg.Endpoint.DeviceAuthURL == "" {
+		cfg.Endpoint.DeviceAuthURL = google.Endpoint.DeviceAuthURL
+	}
+	cfg.Scopes = deviceScopes(cfg.Scopes)
+	return &deviceFlow{config: &cfg}
+}
+
+// deviceScopes returns scopes as Google's device endpoint accepts them. It
+// only allows the youtube and youtube.readonly scopes, so youtube.force-ssl
+// is requested as youtube, which also lets playlists be changed.
+func deviceScopes(scopes []string) []string {
+	mapped := make([]string, len(scopes))
+	for i, scope := range scopes {
+		if scope == youtube.YoutubeForceSslScope {
+			scope = youtube.YoutubeScope
+		}
+		mapped[i] = scope
+	}
+	return mapped
+}
+
+// Token requests a user code, asks the user to enter it on another device and
+// polls the token endpoint until the grant is approved, denied or expires.
+// Polling honours the server-provided interval and backs off on slow_down.
+func (f *deviceFlow) Token(ctx context.Context) (*oauth2.Token, error) {
+	response, err := f.config.DeviceAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to start device authorization: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "🔐 To authorize youtube-manager, visit:\n   %s\n", response.VerificationURI)
+	fmt.Fprintf(os.Stderr, "   and enter the code: %s\n\n", response.UserCode)
+	fmt.Fprintf(os.Stderr, "⏳ Waiting for authorization...\n")
+
+	token, err := f.config.DeviceAccessToken(ctx, response)
+	if err != nil {
+		return nil, fmt.Errorf("device authorization failed: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Authorization complete\n\n")
+	return token, nil
+}
@@ -0,0 +1,178 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// loopbackTimeout bounds how long we wait for the browser to redirect back.
+const loopbackTimeout = 5 * time.Minute
+
+// loopbackFlow runs the OAuth 2.0 authorization code flow for installed apps:
+// the authorization code is delivered to a one-shot HTTP listener on 127.0.0.1,
+// protected by PKCE and a random state value.
+type loopbackFlow struct {
+	config *oauth2.Config
+	// openBrowser opens the authorization URL. It may be replaced in tests to
+	// drive the redirect against a fake authorization server.
+	openBrowser func(url string) error
+	timeout     time.Duration
+	// includeGrantedScopes asks Google to add the scopes the user granted
+	// before. It is only set when upgrading to broader access, otherwise a
+	// read-only login would silently receive write scopes too.
+	includeGrantedScopes bool
+}
+
+// newLoopbackFlow creates a loopback flow using the system browser.
+func newLoopbackFlow(config *oauth2.Config) *loopbackFlow {
+	return &loopbackFlow{
+		config:      config,
+		openBrowser: openBrowser,
+		timeout:     loopbackTimeout,
+	}
+}
+
+// callbackResult carries the outcome of the redirect back to the listener.
+type callbackResult struct {
+	code string
+	err  error
+}
+
+// Token performs the flow and exchanges the received code for a token.
+func (f *loopbackFlow) Token(ctx context.Context) (*oauth2.Token, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("unable to start local callback listener: %w", err)
+	}
+
+	config := *f.config
+	config.RedirectURL = fmt.Sprintf("http://%s/", listener.Addr().String())
+
+	state, err := randomState()
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	results := make(chan callbackResult, 1)
+	server := &http.Server{
+		Handler:           f.callbackHandler(state, results),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go server.Serve(listener)
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	// Asking for the account lets users pick a brand channel for new
+	// profiles; include_granted_scopes makes upgrades incremental.
+	options := []oauth2.AuthCodeOption{
+		oauth2.AccessTypeOffline,
+		oauth2.S256ChallengeOption(verifier),
+		oauth2.SetAuthURLParam("prompt", "select_account consent"),
+	}
+	if f.includeGrantedScopes {
+		options = append(options, oauth2.SetAuthURLParam("include_granted_scopes", "true"))
+	}
+	authURL := config.AuthCodeURL(state, options...)
+	fmt.Fprintf(os.Stderr, "🔐 Opening your browser to authorize youtube-manager...\n")
+	if err := f.openBrowser(authURL); err != nil {
+		fmt.Fprintf(os.Stderr, "   Could not open a browser automatically.\n")
+	}
+	fmt.Fprintf(os.Stderr, "   If nothing happens, open this link:\n   %s\n\n", authURL)
+
+	waitCtx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	var result callbackResult
+	select {
+	case result = <-results:
+	case <-waitCtx.Done():
+		return nil, fmt.Errorf("timed out waiting for authorization: %w", waitCtx.Err())
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+
+	token, err := config.Exchange(ctx, result.code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("unable to exchange authorization code: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Authorization complete\n\n")
+	return token, nil
+}
+
+// callbackHandler handles the redirect from the authorization server. Only the
+// first valid callback is reported; later requests are answered but ignored.
+func (f *loopbackFlow) callbackHandler(state string, results chan<- callbackResult) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+
+		query := r.URL.Query()
+		var result callbackResult
+		switch {
+		case subtle.ConstantTimeCompare([]byte(query.Get("state")), []byte(state)) != 1:
+			// A mismatched state is either a stale tab or a forged request:
+			// reject it without aborting the flow.
+			http.Error(w, "Invalid state parameter.", http.StatusBadRequest)
+			return
+		case query.Get("error") != "":
+			result.err = fmt.Errorf("authorization denied: %s", query.Get("error"))
+			http.Error(w, "Authorization failed. You can close this window.", http.StatusForbidden)
+		case query.Get("code") == "":
+			result.err = errors.New("authorization response did not include a code")
+			http.Error(w, "Missing authorization code.", http.StatusBadRequest)
+		default:
+			result.code = query.Get("code")
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			fmt.Fprint(w, "<html><body><h3>youtube-manager is authorized.</h3><p>You can close this window.</p></body></html>")
+		}
+
+		select {
+		case results <- result:
+		default:
+		}
+	})
+}
+
+// randomState returns an unguessable value for the OAuth state parameter.
+func randomState() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("unable to generate state: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// openBrowser opens url in the user's default browser.
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
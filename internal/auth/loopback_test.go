@@ -0,0 +1,169 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	testClientID = "test-client"
+	testCode     = "test-code"
+)
+
+// fakeTokenServer is an OAuth token endpoint that only accepts testCode with
+// the verifier matching the challenge sent in the authorization URL.
+type fakeTokenServer struct {
+	t         *testing.T
+	challenge string
+	exchanges int
+}
+
+func (s *fakeTokenServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.exchanges++
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	switch {
+	case r.PostForm.Get("grant_type") != "authorization_code":
+		s.t.Errorf("grant_type = %q, want authorization_code", r.PostForm.Get("grant_type"))
+	case r.PostForm.Get("code") != testCode:
+		s.t.Errorf("code = %q, want %q", r.PostForm.Get("code"), testCode)
+	case r.PostForm.Get("code_verifier") == "":
+		s.t.Errorf("token request has no code_verifier")
+	case base64.RawURLEncoding.EncodeToString(sum[:]) != s.challenge:
+		s.t.Errorf("code_verifier does not match the code_challenge")
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  "access",
+			"refresh_token": "refresh",
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+		})
+		return
+	}
+	http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+}
+
+// newTestFlow returns a loopback flow whose browser is replaced by redirect,
+// which receives the parsed authorization URL.
+func newTestFlow(t *testing.T, tokens *fakeTokenServer, redirect func(authURL *url.URL)) *loopbackFlow {
+	server := httptest.NewServer(tokens)
+	t.Cleanup(server.Close)
+
+	config := &oauth2.Config{
+		ClientID: testClientID,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   "https://accounts.example.com/auth",
+			TokenURL:  server.URL,
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+		Scopes: ReadOnly.Scopes,
+	}
+
+	flow := newLoopbackFlow(config)
+	flow.timeout = 5 * time.Second
+	flow.openBrowser = func(rawURL string) error {
+		authURL, err := url.Parse(rawURL)
+		if err != nil {
+			t.Fatalf("invalid authorization URL: %v", err)
+		}
+		tokens.challenge = authURL.Query().Get("code_challenge")
+		redirect(authURL)
+		return nil
+	}
+	return flow
+}
+
+// callback sends the authorization server's redirect to the flow's listener
+// and returns the response status.
+func callback(t *testing.T, authURL *url.URL, params url.Values) int {
+	resp, err := http.Get(authURL.Query().Get("redirect_uri") + "?" + params.Encode())
+	if err != nil {
+		t.Fatalf("callback request failed: %v", err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestLoopbackFlowExchangesCode(t *testing.T) {
+	tokens := &fakeTokenServer{t: t}
+	flow := newTestFlow(t, tokens, func(authURL *url.URL) {
+		query := authURL.Query()
+		if got := query.Get("code_challenge_method"); got != "S256" {
+			t.Errorf("code_challenge_method = %q, want S256", got)
+		}
+		if query.Get("state") == "" {
+			t.Errorf("authorization URL has no state")
+		}
+		if query.Has("include_granted_scopes") {
+			t.Errorf("read-only login asks for previously granted scopes")
+		}
+
+		forged := url.Values{"state": {"forged"}, "code": {"forged-code"}}
+		if status := callback(t, authURL, forged); status != http.StatusBadRequest {
+			t.Errorf("callback with wrong state: status %d, want %d", status, http.StatusBadRequest)
+		}
+
+		valid := url.Values{"state": {query.Get("state")}, "code": {testCode}}
+		if status := callback(t, authURL, valid); status != http.StatusOK {
+			t.Errorf("callback with valid state: status %d, want %d", status, http.StatusOK)
+		}
+	})
+
+	token, err := flow.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Token() error: %v", err)
+	}
+	if token.AccessToken != "access" || token.RefreshToken != "refresh" {
+		t.Errorf("Token() = %+v, want the token from the fake server", token)
+	}
+	if tokens.exchanges != 1 {
+		t.Errorf("token endpoint called %d times, want 1", tokens.exchanges)
+	}
+}
+
+func TestLoopbackFlowRejectsWrongState(t *testing.T) {
+	tokens := &fakeTokenServer{t: t}
+	flow := newTestFlow(t, tokens, func(authURL *url.URL) {
+		forged := url.Values{"state": {"forged"}, "code": {testCode}}
+		if status := callback(t, authURL, forged); status != http.StatusBadRequest {
+			t.Errorf("callback with wrong state: status %d, want %d", status, http.StatusBadRequest)
+		}
+	})
+	flow.timeout = 200 * time.Millisecond
+
+	if _, err := flow.Token(context.Background()); err == nil {
+		t.Fatal("Token() succeeded with only a forged callback")
+	}
+	if tokens.exchanges != 0 {
+		t.Errorf("token endpoint called %d times, want 0", tokens.exchanges)
+	}
+}
+
+func TestLoopbackFlowDenied(t *testing.T) {
+	tokens := &fakeTokenServer{t: t}
+	flow := newTestFlow(t, tokens, func(authURL *url.URL) {
+		denied := url.Values{"state": {authURL.Query().Get("state")}, "error": {"access_denied"}}
+		callback(t, authURL, denied)
+	})
+
+	if _, err := flow.Token(context.Background()); err == nil {
+		t.Fatal("Token() succeeded after the user denied access")
+	}
+	if tokens.exchanges != 0 {
+		t.Errorf("token endpoint called %d times, want 0", tokens.exchanges)
+	}
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/youtube/v3"
+)
+
+// ScopeSet is a named set of OAuth scopes. Commands declare the scope set
+// they need, and tokens are stored separately for each set so read-only
+// commands never hold write access.
+type ScopeSet struct {
+	Name   string
+	Scopes []string
+	// broader lists scope sets whose tokens also satisfy this one.
+	broader []ScopeSet
+	// public marks scope sets that only read public data, so an API key or
+	// a service account can be used instead of a user's OAuth token.
+	public bool
+}
+
+var (
+	// Manage grants full access to the user's YouTube account. It is needed
+	// by commands that change playlists.
+	Manage = ScopeSet{
+		Name:   "manage",
+		Scopes: []string{youtube.YoutubeReadonlyScope, youtube.YoutubeForceSslScope},
+	}
+
+	// ReadOnly grants read access to the user's account and public data.
+	ReadOnly = ScopeSet{
+		Name:    "readonly",
+		Scopes:  []string{youtube.YoutubeReadonlyScope},
+		broader: []ScopeSet{Manage},
+	}
+
+	// Public grants read access to public data only. Commands using it
+	// also work with an API key or a service account.
+	Public = ScopeSet{
+		Name:    ReadOnly.Name,
+		Scopes:  ReadOnly.Scopes,
+		broader: ReadOnly.broader,
+		public:  true,
+	}
+
+	scopeSets = []ScopeSet{Manage, ReadOnly}
+)
+
+// tokenName returns the name under which a profile's token for this scope
+// set is stored. Manage tokens keep the historical, suffix-less name.
+func (s ScopeSet) tokenName(profile string) string {
+	if s.Name == Manage.Name {
+		return profileTokenName(profile)
+	}
+	return fmt.Sprintf("%s_%s", profileTokenName(profile), s.Name)
+}
+
+// candidateTokenNames returns the token names that satisfy this scope set,
+// narrowest first.
+func (s ScopeSet) candidateTokenNames(profile string) []string {
+	names := []string{s.tokenName(profile)}
+	for _, broader := range s.broader {
+		names = append(names, broader.tokenName(profile))
+	}
+	return names
+}
+
+// covers reports whether every scope in scopes belongs to the set.
+func (s ScopeSet) covers(scopes []string) bool {
+	for _, scope := range scopes {
+		if !slices.Contains(s.Scopes, scope) {
+			return false
+		}
+	}
+	return true
+}
+
+// storedScopeSet returns the scope set a new token is stored under: the
+// requested one, unless the token was granted scopes beyond it. Such a token
+// is stored as a Manage token, so narrower tokens never hold write access.
+func storedScopeSet(requested ScopeSet, token *oauth2.Token) ScopeSet {
+	granted, _ := token.Extra("scope").(string)
+	if granted == "" || requested.covers(strings.Fields(granted)) {
+		return requested
+	}
+	return Manage
+}
+
+// profileTokenNames returns every token name a profile may use.
+func profileTokenNames(profile string) []string {
+	names := make([]string, 0, len(scopeSets))
+	for _, set := range scopeSets {
+		names = append(names, set.tokenName(profile))
+	}
+	return names
+}
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestStoredScopeSet(t *testing.T) {
+	withScope := func(scope string) *oauth2.Token {
+		return (&oauth2.Token{AccessToken: "access"}).WithExtra(map[string]any{"scope": scope})
+	}
+	readOnly := ReadOnly.Scopes[0]
+	forceSSL := Manage.Scopes[1]
+
+	tests := []struct {
+		name      string
+		requested ScopeSet
+		token     *oauth2.Token
+		want      string
+	}{
+		{"read-only grant", ReadOnly, withScope(readOnly), ReadOnly.Name},
+		{"no scope in response", ReadOnly, &oauth2.Token{AccessToken: "access"}, ReadOnly.Name},
+		{"previously granted write scope", ReadOnly, withScope(readOnly + " " + forceSSL), Manage.Name},
+		{"manage grant", Manage, withScope(forceSSL + " " + readOnly), Manage.Name},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := storedScopeSet(tt.requested, tt.token); got.Name != tt.want {
+				t.Errorf("storedScopeSet() = %s, want %s", got.Name, tt.want)
+			}
+		})
+	}
+}
+
+func TestProfileTokenNamesAreDistinct(t *testing.T) {
+	profiles := []string{DefaultProfile, "work", "readonly", "work_readonly", "work-readonly", "manage"}
+
+	owners := make(map[string]string)
+	for _, profile := range profiles {
+		if err := ValidateProfileName(profile); err != nil {
+			continue
+		}
+		for _, name := range profileTokenNames(profile) {
+			if owner, ok := owners[name]; ok {
+				t.Errorf("profiles %q and %q both store token %s", owner, profile, name)
+			}
+			owners[name] = profile
+		}
+	}
+
+	for _, reserved := range []string{"readonly", "work_readonly"} {
+		if ValidateProfileName(reserved) == nil {
+			t.Errorf("ValidateProfileName(%q) accepted a reserved name", reserved)
+		}
+	}
+}
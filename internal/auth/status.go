@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// Google endpoints used to inspect and revoke tokens. They are variables so
+// they can be pointed at a fake server.
+var (
+	tokenInfoURL = "https://oauth2.googleapis.com/tokeninfo"
+	revokeURL    = "https://oauth2.googleapis.com/revoke"
+)
+
+// ErrNotSignedIn is returned when the profile has no saved token.
+var ErrNotSignedIn = errors.New("not signed in")
+
+// TokenInfo describes the token saved for a profile.
+type TokenInfo struct {
+	Scopes          []string
+	Expiry          time.Time
+	HasRefreshToken bool
+}
+
+// TokenInfo refreshes the saved token if needed and asks Google which scopes
+// it grants. It never starts an interactive login.
+func (c *Client) TokenInfo(ctx context.Context) (*TokenInfo, error) {
+	token, err := c.validToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{"access_token": {token.AccessToken}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenInfoURL+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching token info: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return nil, fmt.Errorf("error fetching token info: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var payload struct {
+		Scope string `json:"scope"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("error decoding token info: %w", err)
+	}
+
+	return &TokenInfo{
+		Scopes:          strings.Fields(payload.Scope),
+		Expiry:          token.Expiry,
+		HasRefreshToken: token.RefreshToken != "",
+	}, nil
+}
+
+// Logout revokes every saved token of the profile at Google and deletes them
+// locally. Local tokens are deleted even when revocation fails, so a broken
+// token can always be cleared.
+func (c *Client) Logout(ctx context.Context) error {
+	var found bool
+	var revokeErr error
+	for _, name := range profileTokenNames(c.profile) {
+		token, err := c.store.Load(name)
+		if errors.Is(err, ErrTokenNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		found = true
+
+		// Revoking the refresh token also invalidates its access tokens.
+		value := token.RefreshToken
+		if value == "" {
+			value = token.AccessToken
+		}
+		if err := revokeToken(ctx, value); err != nil {
+			slog.Warn("Unable to revoke token", "name", name, "error", err)
+			revokeErr = err
+		}
+	}
+
+	if !found {
+		return ErrNotSignedIn
+	}
+
+	if err := c.RemoveToken(); err != nil {
+		return err
+	}
+
+	return revokeErr
+}
+
+// validToken loads the saved token and refreshes it if it has expired.
+func (c *Client) validToken(ctx context.Context) (*oauth2.Token, error) {
+	saved, err := c.loadToken()
+	if errors.Is(err, ErrTokenNotFound) {
+		return nil, ErrNotSignedIn
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := c.oauthConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := config.TokenSource(ctx, saved).Token()
+	if err != nil {
+		return nil, fmt.Errorf("unable to refresh token: %w", err)
+	}
+
+	if token.AccessToken != saved.AccessToken {
+		if err := c.saveToken(token); err != nil {
+			slog.Warn("Unable to save refreshed token", "error", err)
+		}
+	}
+
+	return token, nil
+}
+
+// revokeToken revokes an access or refresh token at Google's revoke endpoint.
+func revokeToken(ctx context.Context, token string) error {
+	form := url.Values{"token": {token}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, revokeURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error revoking token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return fmt.Errorf("error revoking token: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
@@ -0,0 +1,457 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/zalando/go-keyring"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/oauth2"
+	"golang.org/x/term"
+)
+
+// Token store backends.
+const (
+	StoreFile      = "file"
+	StoreKeyring   = "keyring"
+	StoreEncrypted = "encrypted"
+
+	// TokenStoreEnv overrides the configured token store backend.
+	TokenStoreEnv = "YTM_TOKEN_STORE"
+
+	// PassphraseEnv provides the passphrase of the encrypted token store.
+	PassphraseEnv = "YTM_TOKEN_PASSPHRASE"
+
+	keyringService = "youtube-manager"
+)
+
+// StoreBackends lists the supported token store backends.
+var StoreBackends = []string{StoreFile, StoreKeyring, StoreEncrypted}
+
+// ErrTokenNotFound is returned by a TokenStore when no token is saved under
+// the requested name.
+var ErrTokenNotFound = errors.New("token not found")
+
+// TokenStore persists OAuth tokens under a name.
+type TokenStore interface {
+	// Load returns the token saved under name, or ErrTokenNotFound.
+	Load(name string) (*oauth2.Token, error)
+	// Save stores token under name, replacing any previous token.
+	Save(name string, token *oauth2.Token) error
+	// Delete removes the token saved under name. Deleting a missing token
+	// is not an error.
+	Delete(name string) error
+}
+
+// NewTokenStore creates the token store backend called kind. Tokens that live
+// on disk are kept in dir.
+func NewTokenStore(kind, dir string) (TokenStore, error) {
+	switch kind {
+	case StoreFile, "":
+		return &fileStore{dir: dir}, nil
+	case StoreKeyring:
+		return keyringStore{}, nil
+	case StoreEncrypted:
+		return &encryptedStore{dir: dir, passphrase: promptPassphrase}, nil
+	default:
+		return nil, fmt.Errorf("unknown token store %q (valid: %v)", kind, StoreBackends)
+	}
+}
+
+// fileStore keeps each token as a plaintext JSON file readable only by the
+// owner. This is the historical storage format.
+type fileStore struct {
+	dir string
+}
+
+func (s *fileStore) path(name string) string {
+	return filepath.Join(s.dir, name+".json")
+}
+
+// Load reads a token file.
+func (s *fileStore) Load(name string) (*oauth2.Token, error) {
+	data, err := os.ReadFile(s.path(name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	token := &oauth2.Token{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, fmt.Errorf("failed to decode token: %w", err)
+	}
+
+	return token, nil
+}
+
+// Save writes a token file atomically.
+func (s *fileStore) Save(name string, token *oauth2.Token) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("failed to encode token: %w", err)
+	}
+
+	slog.Info("Saving credentials", "path", s.path(name))
+	return writeFileAtomic(s.path(name), data)
+}
+
+// Delete removes a token file.
+func (s *fileStore) Delete(name string) error {
+	if err := os.Remove(s.path(name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove token file: %w", err)
+	}
+	return nil
+}
+
+// keyringStore keeps tokens in the OS keyring: the Secret Service over D-Bus
+// on Linux, the Keychain on macOS and the Credential Manager on Windows.
+type keyringStore struct{}
+
+// Load reads a token from the keyring.
+func (keyringStore) Load(name string) (*oauth2.Token, error) {
+	secret, err := keyring.Get(keyringService, name)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return nil, ErrTokenNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token from keyring: %w", err)
+	}
+
+	token := &oauth2.Token{}
+	if err := json.Unmarshal([]byte(secret), token); err != nil {
+		return nil, fmt.Errorf("failed to decode token: %w", err)
+	}
+
+	return token, nil
+}
+
+// Save writes a token to the keyring.
+func (keyringStore) Save(name string, token *oauth2.Token) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("failed to encode token: %w", err)
+	}
+
+	slog.Info("Saving credentials to keyring", "name", name)
+	if err := keyring.Set(keyringService, name, string(data)); err != nil {
+		return fmt.Errorf("failed to write token to keyring: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes a token from the keyring.
+func (keyringStore) Delete(name string) error {
+	if err := keyring.Delete(keyringService, name); err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		return fmt.Errorf("failed to remove token from keyring: %w", err)
+	}
+	return nil
+}
+
+// scrypt parameters recommended for interactive logins.
+const (
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+)
+
+// encryptedToken is the on-disk format of the encrypted store: the token JSON
+// sealed with AES-256-GCM under a key derived from a passphrase with scrypt.
+type encryptedToken struct {
+	Version    int    `json:"version"`
+	N          int    `json:"n"`
+	R          int    `json:"r"`
+	P          int    `json:"p"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// encryptedStore keeps each token in a passphrase-encrypted file.
+type encryptedStore struct {
+	dir        string
+	passphrase func() ([]byte, error)
+
+	once   sync.Once
+	secret []byte
+	err    error
+}
+
+func (s *encryptedStore) path(name string) string {
+	return filepath.Join(s.dir, name+".json.enc")
+}
+
+// key returns the passphrase, asking for it at most once.
+func (s *encryptedStore) key() ([]byte, error) {
+	s.once.Do(func() {
+		s.secret, s.err = s.passphrase()
+	})
+	return s.secret, s.err
+}
+
+// Load decrypts a token file.
+func (s *encryptedStore) Load(name string) (*oauth2.Token, error) {
+	data, err := os.ReadFile(s.path(name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var sealed encryptedToken
+	if err := json.Unmarshal(data, &sealed); err != nil {
+		return nil, fmt.Errorf("failed to decode encrypted token: %w", err)
+	}
+	if sealed.Version != 1 {
+		return nil, fmt.Errorf("unsupported encrypted token version %d", sealed.Version)
+	}
+
+	passphrase, err := s.key()
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := newGCM(passphrase, sealed.Salt, sealed.N, sealed.R, sealed.P)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := gcm.Open(nil, sealed.Nonce, sealed.Ciphertext, nil)
+	if err != nil {
+		return nil, errors.New("failed to decrypt token: wrong passphrase or corrupted file")
+	}
+
+	token := &oauth2.Token{}
+	if err := json.Unmarshal(plaintext, token); err != nil {
+		return nil, fmt.Errorf("failed to decode token: %w", err)
+	}
+
+	return token, nil
+}
+
+// Save encrypts a token with a fresh salt and nonce and writes it atomically.
+func (s *encryptedStore) Save(name string, token *oauth2.Token) error {
+	plaintext, err := json.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("failed to encode token: %w", err)
+	}
+
+	passphrase, err := s.key()
+	if err != nil {
+		return err
+	}
+
+	sealed := encryptedToken{Version: 1, N: scryptN, R: scryptR, P: scryptP, Salt: make([]byte, 16)}
+	if _, err := rand.Read(sealed.Salt); err != nil {
+		return fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	gcm, err := newGCM(passphrase, sealed.Salt, sealed.N, sealed.R, sealed.P)
+	if err != nil {
+		return err
+	}
+
+	sealed.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(sealed.Nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed.Ciphertext = gcm.Seal(nil, sealed.Nonce, plaintext, nil)
+
+	data, err := json.Marshal(sealed)
+	if err != nil {
+		return fmt.Errorf("failed to encode encrypted token: %w", err)
+	}
+
+	slog.Info("Saving encrypted credentials", "path", s.path(name))
+	return writeFileAtomic(s.path(name), data)
+}
+
+// Delete removes an encrypted token file.
+func (s *encryptedStore) Delete(name string) error {
+	if err := os.Remove(s.path(name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove token file: %w", err)
+	}
+	return nil
+}
+
+// newGCM derives an AES-256-GCM cipher from a passphrase.
+func newGCM(passphrase, salt []byte, n, r, p int) (cipher.AEAD, error) {
+	key, err := scrypt.Key(passphrase, salt, n, r, p, scryptKeyLen)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// promptPassphrase reads the encrypted store passphrase from the environment
+// or, failing that, from the terminal.
+func promptPassphrase() ([]byte, error) {
+	return readPassphrase(false)
+}
+
+// promptNewPassphrase is promptPassphrase for tokens about to be encrypted:
+// a passphrase typed on the terminal is asked for twice, so a typo cannot
+// lock the tokens away.
+func promptNewPassphrase() ([]byte, error) {
+	return readPassphrase(true)
+}
+
+func readPassphrase(confirm bool) ([]byte, error) {
+	if passphrase := os.Getenv(PassphraseEnv); passphrase != "" {
+		return []byte(passphrase), nil
+	}
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return nil, fmt.Errorf("the encrypted token store needs a passphrase: set %s", PassphraseEnv)
+	}
+
+	fmt.Fprintf(os.Stderr, "🔑 Token store passphrase: ")
+	passphrase, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read passphrase: %w", err)
+	}
+	if len(passphrase) == 0 {
+		return nil, errors.New("empty passphrase")
+	}
+
+	if confirm {
+		fmt.Fprintf(os.Stderr, "🔑 Repeat the passphrase: ")
+		repeated, err := term.ReadPassword(fd)
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read passphrase: %w", err)
+		}
+		if !bytes.Equal(passphrase, repeated) {
+			return nil, errors.New("the passphrases do not match")
+		}
+	}
+
+	return passphrase, nil
+}
+
+// writeFileAtomic writes data to a temporary file with mode 0600 and renames it
+// into place, so a crash never leaves a truncated file behind.
+func writeFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create credentials directory: %w", err)
+	}
+
+	// CreateTemp opens the file with mode 0600.
+	file, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create token file: %w", err)
+	}
+	tmpPath := file.Name()
+	defer os.Remove(tmpPath)
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to replace token file: %w", err)
+	}
+
+	return nil
+}
+
+// MigrateTokens copies every profile's tokens from the store backend called
+// from to the one called to, makes to the configured backend and then deletes
+// the originals. It returns the number of tokens moved.
+func MigrateTokens(from, to string) (int, error) {
+	config, err := LoadConfig()
+	if err != nil {
+		return 0, err
+	}
+	if from == "" {
+		from = config.StoreBackend()
+	}
+	if from == to {
+		return 0, fmt.Errorf("tokens are already stored in the %s store", to)
+	}
+
+	dir, err := credentialsDir()
+	if err != nil {
+		return 0, err
+	}
+	src, err := NewTokenStore(from, dir)
+	if err != nil {
+		return 0, err
+	}
+	dst, err := NewTokenStore(to, dir)
+	if err != nil {
+		return 0, err
+	}
+	if encrypted, ok := dst.(*encryptedStore); ok {
+		encrypted.passphrase = promptNewPassphrase
+	}
+
+	var moved []string
+	for _, profile := range config.ProfileNames() {
+		for _, name := range profileTokenNames(profile) {
+			token, err := src.Load(name)
+			if errors.Is(err, ErrTokenNotFound) {
+				continue
+			}
+			if err != nil {
+				return 0, fmt.Errorf("unable to read token %s: %w", name, err)
+			}
+			if err := dst.Save(name, token); err != nil {
+				return 0, fmt.Errorf("unable to migrate token %s: %w", name, err)
+			}
+			copied, err := dst.Load(name)
+			if err != nil {
+				return 0, fmt.Errorf("unable to read back migrated token %s: %w", name, err)
+			}
+			if copied.AccessToken != token.AccessToken || copied.RefreshToken != token.RefreshToken {
+				return 0, fmt.Errorf("migrated token %s does not match the original", name)
+			}
+			moved = append(moved, name)
+		}
+	}
+
+	config.TokenStore = to
+	if err := config.Save(); err != nil {
+		return 0, err
+	}
+
+	// Only remove the originals once every copy and the new configuration
+	// are safely written.
+	for _, name := range moved {
+		if err := src.Delete(name); err != nil {
+			slog.Warn("Unable to delete migrated token", "name", name, "error", err)
+		}
+	}
+
+	return len(moved), nil
+}
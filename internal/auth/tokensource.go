@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// persistingTokenSource refreshes tokens through the OAuth config, writes every
+// rotated token back to the token file and signs in again when the refresh
+// token has been revoked or has expired.
+type persistingTokenSource struct {
+	ctx    context.Context
+	client *Client
+	config *oauth2.Config
+
+	mu      sync.Mutex
+	base    oauth2.TokenSource
+	current *oauth2.Token
+}
+
+// newPersistingTokenSource creates a token source starting from token.
+func newPersistingTokenSource(ctx context.Context, client *Client, config *oauth2.Config, token *oauth2.Token) *persistingTokenSource {
+	return &persistingTokenSource{
+		ctx:     ctx,
+		client:  client,
+		config:  config,
+		base:    config.TokenSource(ctx, token),
+		current: token,
+	}
+}
+
+// Token returns a valid token, persisting it if it changed since the last call.
+func (s *persistingTokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, err := s.base.Token()
+	if err != nil {
+		if !isInvalidGrant(err) {
+			return nil, err
+		}
+
+		slog.Info("Refresh token rejected, re-authenticating", "error", err)
+		fmt.Fprintf(os.Stderr, "⚠️  Saved authorization is no longer valid, please sign in again.\n\n")
+
+		token, err = s.client.authenticate(s.ctx, s.config)
+		if err != nil {
+			return nil, err
+		}
+		s.base = s.config.TokenSource(s.ctx, token)
+	}
+
+	if token.AccessToken != s.current.AccessToken || token.RefreshToken != s.current.RefreshToken {
+		if err := s.client.saveToken(token); err != nil {
+			slog.Warn("Unable to save refreshed token", "error", err)
+		}
+		s.current = token
+	}
+
+	return token, nil
+}
+
+// isInvalidGrant reports whether err is the token endpoint rejecting the
+// refresh token, which no amount of retrying will fix.
+func isInvalidGrant(err error) bool {
+	var retrieveErr *oauth2.RetrieveError
+	return errors.As(err, &retrieveErr) && retrieveErr.ErrorCode == "invalid_grant"
+}
@@ -0,0 +1,345 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"youtube-manager/internal/auth"
+	"youtube-manager/internal/youtube"
+)
+
+// registerAuthCommands adds the auth command group to the root command.
+func registerAuthCommands() {
+	authCmd := &cobra.Command{
+		Use:   "auth",
+		Short: "Manage authentication and profiles",
+		Long:  "Sign in and out, inspect tokens and manage named profiles, one per Google account or brand channel",
+	}
+
+	authCmd.AddCommand(createAuthLoginCmd())
+	authCmd.AddCommand(createAuthStatusCmd())
+	authCmd.AddCommand(createAuthLogoutCmd())
+	authCmd.AddCommand(createAuthListCmd())
+	authCmd.AddCommand(createAuthAddCmd())
+	authCmd.AddCommand(createAuthRemoveCmd())
+	authCmd.AddCommand(createAuthUseCmd())
+	authCmd.AddCommand(createAuthMigrateCmd())
+
+	rootCmd.AddCommand(authCmd)
+}
+
+// createAuthLoginCmd creates the auth login command.
+func createAuthLoginCmd() *cobra.Command {
+	var readOnly bool
+
+	cmd := &cobra.Command{
+		Use:   "login",
+		Short: "Sign in again, replacing the saved token",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			scopes := auth.Manage
+			if readOnly {
+				scopes = auth.ReadOnly
+			}
+			return runAuthLogin(cmd.Context(), scopes)
+		},
+	}
+
+	cmd.Flags().BoolVar(&readOnly, "read-only", false, "Only request read-only access")
+	return cmd
+}
+
+func runAuthLogin(ctx context.Context, scopes auth.ScopeSet) error {
+	authClient, err := newAuthClient(scopes)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "👤 Signing in to profile: %s...\n\n", authClient.Profile())
+	if err := authClient.Login(ctx); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Signed in successfully!\n")
+	return nil
+}
+
+// createAuthStatusCmd creates the auth status command.
+func createAuthStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show the channel, scopes and expiry of the saved token",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAuthStatus(cmd.Context())
+		},
+	}
+}
+
+func runAuthStatus(ctx context.Context) error {
+	authClient, err := newAuthClient(auth.ReadOnly)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("👤 Profile: %s\n", authClient.Profile())
+
+	info, err := authClient.TokenInfo(ctx)
+	if errors.Is(err, auth.ErrNotSignedIn) {
+		fmt.Printf("   Status: not signed in\n")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	service, err := authClient.GetYouTubeService(ctx)
+	if err != nil {
+		return err
+	}
+
+	channel, err := youtube.NewChannelService(service).Mine(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("   Channel: %s\n", channel.Snippet.Title)
+	fmt.Printf("   Channel ID: %s\n", channel.Id)
+	fmt.Printf("   Scopes: %s\n", strings.Join(info.Scopes, ", "))
+	fmt.Printf("   Access token expires: %s\n", info.Expiry.Local().Format(time.RFC1123))
+	if !info.HasRefreshToken {
+		fmt.Printf("   Refresh token: missing (you will need to sign in again when it expires)\n")
+	}
+
+	return nil
+}
+
+// createAuthLogoutCmd creates the auth logout command.
+func createAuthLogoutCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "logout",
+		Short: "Revoke the saved token and delete it",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAuthLogout(cmd.Context())
+		},
+	}
+}
+
+func runAuthLogout(ctx context.Context) error {
+	authClient, err := newAuthClient(auth.ReadOnly)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "🚪 Signing out of profile: %s...\n\n", authClient.Profile())
+
+	if err := authClient.Logout(ctx); err != nil {
+		if errors.Is(err, auth.ErrNotSignedIn) {
+			fmt.Fprintf(os.Stderr, "Profile %s is not signed in.\n", authClient.Profile())
+			return nil
+		}
+		return fmt.Errorf("local token deleted, but %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Token revoked and deleted successfully!\n")
+	return nil
+}
+
+// createAuthListCmd creates the auth list command.
+func createAuthListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List authentication profiles",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAuthList()
+		},
+	}
+}
+
+func runAuthList() error {
+	config, err := auth.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	active, err := auth.ResolveProfile(profile)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range config.ProfileNames() {
+		authClient, err := auth.NewClient(auth.Options{Profile: name})
+		if err != nil {
+			return err
+		}
+
+		marker := " "
+		if name == active {
+			marker = "*"
+		}
+		status := "not signed in"
+		if authClient.HasToken() {
+			status = "signed in"
+		}
+		fmt.Printf("%s %s (%s)\n", marker, name, status)
+	}
+
+	return nil
+}
+
+// createAuthAddCmd creates the auth add command.
+func createAuthAddCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "add <name>",
+		Short: "Add a profile and sign in to it",
+		Long:  "Add a profile and sign in to it. Choose the Google account or brand channel to bind to the profile on the consent screen.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAuthAdd(cmd.Context(), args[0])
+		},
+	}
+}
+
+func runAuthAdd(ctx context.Context, name string) error {
+	if err := auth.ValidateProfileName(name); err != nil {
+		return err
+	}
+
+	config, err := auth.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	authClient, err := auth.NewClient(auth.Options{Profile: name, DeviceFlow: deviceAuth, NewProfile: true})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "👤 Signing in to profile: %s...\n\n", name)
+	if err := authClient.Login(ctx); err != nil {
+		return err
+	}
+
+	if err := config.AddProfile(name); err != nil {
+		return err
+	}
+	if err := config.Save(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Profile %s added successfully!\n", name)
+	return nil
+}
+
+// createAuthRemoveCmd creates the auth remove command.
+func createAuthRemoveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove <name>",
+		Short: "Remove a profile and its saved token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAuthRemove(args[0])
+		},
+	}
+}
+
+func runAuthRemove(name string) error {
+	config, err := auth.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	if !config.HasProfile(name) {
+		return fmt.Errorf("unknown profile: %s", name)
+	}
+
+	authClient, err := auth.NewClient(auth.Options{Profile: name})
+	if err != nil {
+		return err
+	}
+
+	if err := authClient.RemoveToken(); err != nil {
+		return err
+	}
+
+	config.RemoveProfile(name)
+	if err := config.Save(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Profile %s removed successfully!\n", name)
+	return nil
+}
+
+// createAuthUseCmd creates the auth use command.
+func createAuthUseCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "use <name>",
+		Short: "Set the default profile",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAuthUse(args[0])
+		},
+	}
+}
+
+func runAuthUse(name string) error {
+	config, err := auth.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	if !config.HasProfile(name) {
+		return fmt.Errorf("unknown profile: %s (add it with 'auth add %s')", name, name)
+	}
+
+	config.DefaultProfile = name
+	if err := config.Save(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Default profile set to %s\n", name)
+	return nil
+}
+
+// createAuthMigrateCmd creates the auth migrate command.
+func createAuthMigrateCmd() *cobra.Command {
+	var from, to string
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Move saved tokens to another token store",
+		Long: fmt.Sprintf("Move the tokens of every profile to another token store and make it the default.\n"+
+			"Stores: %s. The encrypted store reads its passphrase from $%s or prompts for it.",
+			strings.Join(auth.StoreBackends, ", "), auth.PassphraseEnv),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAuthMigrate(from, to)
+		},
+	}
+
+	cmd.Flags().StringVar(&from, "from", "", "Token store to migrate from (defaults to the current store)")
+	cmd.Flags().StringVar(&to, "to", "", "Token store to migrate to (file, keyring, encrypted)")
+	cmd.MarkFlagRequired("to")
+	return cmd
+}
+
+func runAuthMigrate(from, to string) error {
+	fmt.Fprintf(os.Stderr, "🔁 Migrating tokens to the %s store...\n\n", to)
+
+	moved, err := auth.MigrateTokens(from, to)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Migrated %d token(s); the %s store is now the default\n", moved, to)
+	return nil
+}
@@ -0,0 +1,280 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/spf13/cobra"
+	ytapi "google.golang.org/api/youtube/v3"
+
+	"youtube-manager/internal/auth"
+	"youtube-manager/internal/export"
+	"youtube-manager/internal/youtube"
+)
+
+// registerBackupCommands adds the backup and restore commands to the root command.
+func registerBackupCommands() {
+	rootCmd.AddCommand(createBackupCmd())
+	rootCmd.AddCommand(createRestoreCmd())
+}
+
+// createBackupCmd creates the backup command.
+func createBackupCmd() *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Save all your playlists and their videos to a local archive",
+		Long: "Save every playlist of your channel, with its videos in order, to a timestamped\n" +
+			"archive (youtube-backup-<time>.json.gz) that restore can read back.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBackup(cmd.Context(), output)
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", ".", "Directory to write the archive to")
+	return cmd
+}
+
+func runBackup(ctx context.Context, output string) error {
+	authClient, err := newAuthClient(auth.ReadOnly)
+	if err != nil {
+		return err
+	}
+
+	service, err := authClient.GetYouTubeService(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(output, 0755); err != nil {
+		return fmt.Errorf("unable to create output directory: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "🔍 Fetching your playlists...\n\n")
+
+	playlistSvc := youtube.NewPlaylistService(service)
+	playlists, err := playlistSvc.List(ctx, 0)
+	if err != nil {
+		return err
+	}
+
+	backup := export.NewBackup()
+	videos := 0
+	for i, playlist := range playlists {
+		items, err := playlistSvc.GetItems(ctx, playlist.Id, 0)
+		if err != nil {
+			return err
+		}
+
+		snapshot := export.PlaylistBackup{
+			ID:          playlist.Id,
+			Title:       playlist.Snippet.Title,
+			Description: playlist.Snippet.Description,
+			Entries:     make([]export.Entry, len(items)),
+		}
+		if playlist.Status != nil {
+			snapshot.Privacy = playlist.Status.PrivacyStatus
+		}
+		for j, item := range items {
+			snapshot.Entries[j] = newExportEntry(item, nil)
+		}
+
+		backup.Playlists = append(backup.Playlists, snapshot)
+		videos += len(items)
+		fmt.Fprintf(os.Stderr, "[%d/%d] %s (%d videos)\n", i+1, len(playlists), playlist.Snippet.Title, len(items))
+	}
+
+	path := filepath.Join(output, export.BackupFileName(backup.CreatedAt))
+	if err := export.WriteBackupFile(path, backup); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "\n✅ Backed up %d playlist(s) and %d video(s)\n", len(playlists), videos)
+	fmt.Println(path)
+	return nil
+}
+
+// createRestoreCmd creates the restore command.
+func createRestoreCmd() *cobra.Command {
+	var apply bool
+	var into string
+
+	cmd := &cobra.Command{
+		Use:   "restore <archive> [playlist-id]",
+		Short: "Recreate a playlist or roll it back to a backup",
+		Long: "Compare a playlist with its state in a backup archive and show the changes needed to\n" +
+			"restore it. A deleted playlist is recreated with its title, description and privacy;\n" +
+			"pass the new ID with --into to continue an interrupted restore instead of recreating it\n" +
+			"again. Changes are only made with --apply. Without a playlist ID, list the archive's playlists.",
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				return runListBackup(args[0])
+			}
+			return runRestore(cmd.Context(), args[0], args[1], into, apply)
+		},
+	}
+
+	cmd.Flags().BoolVar(&apply, "apply", false, "Apply the changes instead of only showing them")
+	cmd.Flags().StringVar(&into, "into", "", "Restore into this playlist, such as one recreated by an earlier run")
+	return cmd
+}
+
+func runListBackup(path string) error {
+	backup, err := export.ReadBackupFile(path)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Backup taken %s: %d playlist(s)\n\n", backup.CreatedAt.Local().Format("2006-01-02 15:04:05"), len(backup.Playlists))
+	for i, playlist := range backup.Playlists {
+		fmt.Printf("%d. %s\n", i+1, playlist.Title)
+		fmt.Printf("   ID: %s\n", playlist.ID)
+		fmt.Printf("   Videos: %d\n\n", len(playlist.Entries))
+	}
+	return nil
+}
+
+// runRestore brings a playlist back to its backed up state. The snapshot of
+// playlistID is restored into the playlist into if it is set, and into
+// playlistID itself otherwise.
+func runRestore(ctx context.Context, path, playlistID, into string, apply bool) error {
+	backup, err := export.ReadBackupFile(path)
+	if err != nil {
+		return err
+	}
+
+	snapshot := backup.Find(playlistID)
+	if snapshot == nil {
+		return fmt.Errorf("playlist %s is not in backup %s", playlistID, path)
+	}
+
+	scopes := auth.ReadOnly
+	if apply {
+		scopes = auth.Manage
+	}
+
+	authClient, err := newAuthClient(scopes)
+	if err != nil {
+		return err
+	}
+
+	service, err := authClient.GetYouTubeService(ctx)
+	if err != nil {
+		return err
+	}
+
+	playlistSvc := youtube.NewPlaylistService(service)
+	videoSvc := youtube.NewVideoService(service)
+
+	if into != "" {
+		playlistID = into
+	}
+
+	fmt.Fprintf(os.Stderr, "🔍 Comparing playlist %s with the backup of %s...\n\n",
+		playlistID, backup.CreatedAt.Local().Format("2006-01-02 15:04:05"))
+
+	playlist, err := playlistSvc.Get(ctx, playlistID)
+	recreate := errors.Is(err, youtube.ErrPlaylistNotFound) && into == ""
+	if err != nil && !recreate {
+		return err
+	}
+
+	var current []*ytapi.PlaylistItem
+	var update youtube.PlaylistUpdate
+	if recreate {
+		fmt.Printf("* recreate playlist %q (%s)\n", snapshot.Title, snapshot.Privacy)
+	} else {
+		current, err = playlistSvc.GetItems(ctx, playlistID, 0)
+		if err != nil {
+			return err
+		}
+		update = restoreUpdate(playlist, snapshot)
+	}
+
+	desired, titles, err := restorableVideos(ctx, videoSvc, current, snapshot)
+	if err != nil {
+		return err
+	}
+
+	plan := youtube.PlanSync(current, desired)
+	printSyncPlan(plan, titles)
+
+	changed := recreate || update != (youtube.PlaylistUpdate{}) || !plan.Empty()
+	if !apply {
+		if changed {
+			fmt.Fprintf(os.Stderr, "\nℹ️  Nothing was changed. Run again with --apply to restore the playlist.\n")
+		}
+		return nil
+	}
+
+	if recreate {
+		created, err := playlistSvc.Create(ctx, snapshot.Title, snapshot.Description, export.FirstNonEmpty(snapshot.Privacy, "private"))
+		if err != nil {
+			return err
+		}
+		playlistID = created.Id
+		fmt.Fprintf(os.Stderr, "\n📝 Recreated playlist as %s; use --into %s to restore into it again\n", playlistID, playlistID)
+	} else if update != (youtube.PlaylistUpdate{}) {
+		if _, err := playlistSvc.Update(ctx, playlist, update); err != nil {
+			return err
+		}
+	}
+
+	if err := applySyncPlan(ctx, playlistSvc, playlistID, plan); err != nil {
+		if recreate {
+			return fmt.Errorf("%w\nRun again with --into %s to resume", err, playlistID)
+		}
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "\n✅ Playlist %s restored\n", playlistID)
+	return nil
+}
+
+// restoreUpdate prints and returns the metadata changes that bring playlist
+// back to its snapshot.
+func restoreUpdate(playlist *ytapi.Playlist, snapshot *export.PlaylistBackup) youtube.PlaylistUpdate {
+	var update youtube.PlaylistUpdate
+	if playlist.Snippet.Title != snapshot.Title {
+		fmt.Printf("~ title: %q → %q\n", playlist.Snippet.Title, snapshot.Title)
+		update.Title = &snapshot.Title
+	}
+	if playlist.Snippet.Description != snapshot.Description {
+		fmt.Printf("~ description: restore the backed up description\n")
+		update.Description = &snapshot.Description
+	}
+	if snapshot.Privacy != "" && playlist.Status.PrivacyStatus != snapshot.Privacy {
+		fmt.Printf("~ privacy: %s → %s\n", playlist.Status.PrivacyStatus, snapshot.Privacy)
+		update.Privacy = &snapshot.Privacy
+	}
+	return update
+}
+
+// restorableVideos returns the snapshot's videos, leaving out those that are
+// no longer in the playlist and can no longer be added because they were
+// deleted or made private, and the titles of the videos to add.
+func restorableVideos(ctx context.Context, videoSvc *youtube.VideoService, current []*ytapi.PlaylistItem, snapshot *export.PlaylistBackup) ([]string, map[string]string, error) {
+	ids := make([]string, len(snapshot.Entries))
+	for i, entry := range snapshot.Entries {
+		ids[i] = entry.VideoID
+	}
+
+	desired, titles, missing, err := availableVideos(ctx, videoSvc, current, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, entry := range snapshot.Entries {
+		if slices.Contains(missing, entry.VideoID) {
+			fmt.Printf("! skip   %s (%s): no longer available\n", entry.Title, entry.VideoID)
+		}
+	}
+	return desired, titles, nil
+}
@@ -0,0 +1,180 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	ytapi "google.golang.org/api/youtube/v3"
+
+	"youtube-manager/internal/auth"
+	"youtube-manager/internal/export"
+	"youtube-manager/internal/youtube"
+)
+
+// createCheckPlaylistCmd creates the check-playlist command.
+func createCheckPlaylistCmd() *cobra.Command {
+	var region, exportPath, format string
+	var statuses []string
+	var remove bool
+	var s *safety
+
+	cmd := &cobra.Command{
+		Use:   "check-playlist <playlist-id>",
+		Short: "Find deleted, private and unplayable videos in a playlist",
+		Long: "Classify every item of a playlist as ok, deleted, private, own-private (a private video of\n" +
+			"yours, which still plays for you), age-restricted, region-blocked or not-embeddable, and\n" +
+			"report the broken ones. Region restrictions are only checked with --region. The reported\n" +
+			"items can be exported. --remove only removes deleted and private videos, which nobody can\n" +
+			"play, unless --status is given explicitly.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, status := range statuses {
+				if !slices.Contains(youtube.HealthProblems, status) {
+					return fmt.Errorf("invalid --status %q (valid: %s)", status, strings.Join(youtube.HealthProblems, ", "))
+				}
+			}
+			if !slices.Contains(export.Formats, format) {
+				return fmt.Errorf("invalid --format %q (valid: %s)", format, strings.Join(export.Formats, ", "))
+			}
+			if err := youtube.ValidateRegionCode(region); err != nil {
+				return err
+			}
+			removable := youtube.HealthUnavailable
+			if cmd.Flags().Changed("status") {
+				removable = statuses
+			}
+			if !remove {
+				removable = nil
+			}
+			return runCheckPlaylist(cmd.Context(), s, args[0], region, statuses, removable, exportPath, format)
+		},
+	}
+
+	cmd.Flags().StringVar(&region, "region", "", "Two-letter country code used to check region restrictions")
+	cmd.Flags().StringSliceVar(&statuses, "status", youtube.HealthProblems, "Problems to report ("+strings.Join(youtube.HealthProblems, ", ")+")")
+	cmd.Flags().BoolVar(&remove, "remove", false, "Remove deleted and private items (or those matching an explicit --status) from the playlist")
+	cmd.Flags().StringVar(&exportPath, "export", "", "Write the reported items to this file (- for stdout); the note field holds the problem")
+	cmd.Flags().StringVar(&format, "format", "json", "Format of the --export file ("+strings.Join(export.Formats, ", ")+")")
+	s = addSafetyFlags(cmd)
+	return cmd
+}
+
+// brokenItem is a playlist item with a problem found by check-playlist.
+type brokenItem struct {
+	item   *ytapi.PlaylistItem
+	video  *ytapi.Video
+	status string
+}
+
+// runCheckPlaylist reports the items whose status is in statuses and removes
+// those whose status is in removable.
+func runCheckPlaylist(ctx context.Context, s *safety, playlistID, region string, statuses, removable []string, exportPath, format string) error {
+	scopes := auth.Public
+	if len(removable) > 0 {
+		scopes = s.scopes(auth.Manage)
+	}
+
+	authClient, err := newAuthClient(scopes)
+	if err != nil {
+		return err
+	}
+
+	service, err := authClient.GetYouTubeService(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "🩺 Checking playlist: %s...\n\n", playlistID)
+
+	playlistSvc := youtube.NewPlaylistService(service)
+	counts := make(map[string]int)
+	total := 0
+	var broken []brokenItem
+	err = youtube.EachItemWithVideo(ctx, playlistSvc, youtube.NewVideoService(service), playlistID, 0,
+		func(item *ytapi.PlaylistItem, video *ytapi.Video) error {
+			total++
+			status := youtube.CheckItem(item, video, region)
+			counts[status]++
+			if slices.Contains(statuses, status) {
+				broken = append(broken, brokenItem{item: item, video: video, status: status})
+			}
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	for _, b := range broken {
+		fmt.Printf("%d. [%s] %s (%s)\n", b.item.Snippet.Position+1, b.status, b.item.Snippet.Title, b.item.ContentDetails.VideoId)
+	}
+	if len(broken) > 0 {
+		fmt.Println()
+	}
+
+	fmt.Printf("%d item(s) checked: %d ok", total, counts[youtube.HealthOK])
+	for _, status := range youtube.HealthProblems {
+		if counts[status] > 0 {
+			fmt.Printf(", %d %s", counts[status], status)
+		}
+	}
+	fmt.Println()
+
+	if exportPath != "" && len(broken) > 0 {
+		if err := exportBrokenItems(broken, exportPath, format); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "\n📤 Exported %d item(s) to %s\n", len(broken), exportPath)
+	}
+
+	var remove []brokenItem
+	for _, b := range broken {
+		if slices.Contains(removable, b.status) {
+			remove = append(remove, b)
+		}
+	}
+
+	if len(remove) > 0 {
+		if ok, err := s.confirm("\nRemove %d item(s) (%s) from playlist %s.", len(remove), strings.Join(removable, ", "), playlistID); !ok {
+			return err
+		}
+
+		for i, b := range remove {
+			err := s.do("playlistItems.delete id="+b.item.Id, func() error {
+				return playlistSvc.RemoveVideo(ctx, b.item.Id)
+			})
+			if err != nil {
+				return fmt.Errorf("after removing %d item(s): %w", i, err)
+			}
+		}
+		if !s.dryRun {
+			fmt.Fprintf(os.Stderr, "\n✅ Removed %d item(s) from playlist\n", len(remove))
+		}
+	}
+
+	return nil
+}
+
+// exportBrokenItems writes the reported items to path in the given format.
+func exportBrokenItems(broken []brokenItem, path, format string) error {
+	return writeOutputFile(path, func(out io.Writer) error {
+		writer, err := export.NewWriter(format, out)
+		if err != nil {
+			return err
+		}
+
+		for _, b := range broken {
+			entry := newExportEntry(b.item, b.video)
+			entry.Note = b.status
+			if err := writer.Write(entry); err != nil {
+				return err
+			}
+		}
+
+		return writer.Close()
+	})
+}
@@ -3,6 +3,8 @@ package cli
 
 import (
 	"github.com/spf13/cobra"
+
+	"youtube-manager/internal/auth"
 )
 
 var rootCmd = &cobra.Command{
@@ -11,12 +13,36 @@ var rootCmd = &cobra.Command{
 	Long:  "Manage YouTube content using YouTube Data API v3 and yt-dlp",
 }
 
+// Global flags shared by every command.
+var (
+	deviceAuth bool
+	profile    string
+	apiKey     string
+)
+
 // Execute runs the CLI application.
 func Execute() error {
+	registerGlobalFlags()
+
 	// Register all commands
 	registerPlaylistCommands()
 	registerVideoCommands()
 	registerDownloadCommands()
+	registerAuthCommands()
+	registerBackupCommands()
 
 	return rootCmd.Execute()
 }
+
+// registerGlobalFlags adds the persistent flags available to every command.
+func registerGlobalFlags() {
+	rootCmd.PersistentFlags().StringVar(&profile, "profile", "", "Auth profile to use (defaults to $YTM_PROFILE or the configured default)")
+	rootCmd.PersistentFlags().StringVar(&apiKey, "api-key", "", "API key for public read commands (defaults to $YOUTUBE_API_KEY)")
+	rootCmd.PersistentFlags().BoolVar(&deviceAuth, "device", false, "Authenticate with the OAuth device flow (for machines without a browser)")
+}
+
+// newAuthClient creates an auth client configured from the global flags,
+// requesting only the scopes the calling command declares it needs.
+func newAuthClient(scopes auth.ScopeSet) (*auth.Client, error) {
+	return auth.NewClient(auth.Options{Profile: profile, Scopes: scopes, DeviceFlow: deviceAuth, APIKey: apiKey})
+}
@@ -0,0 +1,371 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	ytapi "google.golang.org/api/youtube/v3"
+
+	"youtube-manager/internal/auth"
+	"youtube-manager/internal/youtube"
+)
+
+// maxPlaylistItems is the number of videos YouTube allows in a playlist.
+const maxPlaylistItems = 5000
+
+// dryRunPlaylistID stands for a playlist that a dry run would have created.
+const dryRunPlaylistID = "<new playlist>"
+
+// createMergePlaylistsCmd creates the merge-playlists command.
+func createMergePlaylistsCmd() *cobra.Command {
+	var into string
+	var dedupe bool
+	var s *safety
+
+	cmd := &cobra.Command{
+		Use:   "merge-playlists <source-playlist-id>... --into <playlist-id>",
+		Short: "Append the videos of several playlists to another one",
+		Long: "Append the videos of the source playlists, in order, to the target playlist.\n" +
+			"Videos already in the target playlist are skipped, so an interrupted merge can be run again.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if slices.Contains(args, into) {
+				return fmt.Errorf("the target playlist cannot also be a source")
+			}
+			return runMergePlaylists(cmd.Context(), s, args, into, dedupe)
+		},
+	}
+
+	cmd.Flags().StringVar(&into, "into", "", "Target playlist ID")
+	cmd.Flags().BoolVar(&dedupe, "dedupe", false, "Add each video only once")
+	cmd.MarkFlagRequired("into")
+	s = addSafetyFlags(cmd)
+	return cmd
+}
+
+func runMergePlaylists(ctx context.Context, s *safety, sourceIDs []string, into string, dedupe bool) error {
+	playlistSvc, err := newManagePlaylistService(ctx, s)
+	if err != nil {
+		return err
+	}
+
+	target, err := playlistSvc.Get(ctx, into)
+	if err != nil {
+		return err
+	}
+
+	var videoIDs []string
+	for _, sourceID := range sourceIDs {
+		fmt.Fprintf(os.Stderr, "🔍 Fetching videos from playlist: %s...\n", sourceID)
+		items, err := playlistSvc.GetItems(ctx, sourceID, 0)
+		if err != nil {
+			return err
+		}
+		videoIDs = append(videoIDs, availableVideoIDs(items)...)
+	}
+	fmt.Fprintln(os.Stderr)
+
+	ok, err := s.confirm("Merge %d video(s) from %d playlist(s) into %q; videos already there are skipped.",
+		len(videoIDs), len(sourceIDs), target.Snippet.Title)
+	if !ok {
+		return err
+	}
+
+	if err := copyVideos(ctx, s, playlistSvc, into, videoIDs, dedupe); err != nil {
+		return err
+	}
+	if s.dryRun {
+		return nil
+	}
+
+	fmt.Fprintf(os.Stderr, "\n✅ Merged %d playlist(s) into %s\n", len(sourceIDs), into)
+	return nil
+}
+
+// createClonePlaylistCmd creates the clone-playlist command.
+func createClonePlaylistCmd() *cobra.Command {
+	var title, privacy, into string
+	var s *safety
+
+	cmd := &cobra.Command{
+		Use:   "clone-playlist <playlist-id>",
+		Short: "Copy a playlist, including other channels' public playlists",
+		Long: "Create a playlist of your own holding the same videos as another playlist.\n" +
+			"To resume an interrupted clone, run it again with --into and the ID of the copy:\n" +
+			"only the missing videos are added.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !slices.Contains(privacyStatuses, privacy) {
+				return fmt.Errorf("invalid --privacy %q (valid: %s)", privacy, strings.Join(privacyStatuses, ", "))
+			}
+			if into == args[0] {
+				return fmt.Errorf("the copy cannot be the source playlist")
+			}
+			return runClonePlaylist(cmd.Context(), s, args[0], title, privacy, into)
+		},
+	}
+
+	cmd.Flags().StringVar(&title, "title", "", "Title of the copy (default: the source title)")
+	cmd.Flags().StringVar(&privacy, "privacy", "private", "Privacy status of the copy ("+strings.Join(privacyStatuses, ", ")+")")
+	cmd.Flags().StringVar(&into, "into", "", "ID of a copy made by an earlier run, to resume it")
+	s = addSafetyFlags(cmd)
+	return cmd
+}
+
+func runClonePlaylist(ctx context.Context, s *safety, playlistID, title, privacy, into string) error {
+	playlistSvc, err := newManagePlaylistService(ctx, s)
+	if err != nil {
+		return err
+	}
+
+	source, err := playlistSvc.Get(ctx, playlistID)
+	if err != nil {
+		return err
+	}
+	if title == "" {
+		title = source.Snippet.Title
+	}
+
+	fmt.Fprintf(os.Stderr, "🔍 Fetching videos from playlist: %s...\n\n", playlistID)
+	items, err := playlistSvc.GetItems(ctx, playlistID, 0)
+	if err != nil {
+		return err
+	}
+
+	videoIDs := availableVideoIDs(items)
+	if into != "" {
+		target, err := playlistSvc.Get(ctx, into)
+		if err != nil {
+			return err
+		}
+		title = target.Snippet.Title
+	}
+	if ok, err := s.confirm("Clone %q (%d video(s)) as %s playlist %q.", source.Snippet.Title, len(videoIDs), privacy, title); !ok {
+		return err
+	}
+
+	target, err := resumeOrCreatePlaylist(ctx, s, playlistSvc, into, title, source.Snippet.Description, privacy)
+	if err != nil {
+		return err
+	}
+
+	if err := copyVideos(ctx, s, playlistSvc, target.Id, videoIDs, false); err != nil {
+		return fmt.Errorf("%w\nRun again with --into %s to resume", err, target.Id)
+	}
+	if s.dryRun {
+		return nil
+	}
+
+	fmt.Fprintf(os.Stderr, "\n✅ Playlist cloned successfully!\n")
+	fmt.Printf("   ID: %s\n", target.Id)
+	fmt.Printf("   Link: https://www.youtube.com/playlist?list=%s\n", target.Id)
+	return nil
+}
+
+// createSplitPlaylistCmd creates the split-playlist command.
+func createSplitPlaylistCmd() *cobra.Command {
+	var chunk int
+	var privacy string
+	var into []string
+	var s *safety
+
+	cmd := &cobra.Command{
+		Use:   "split-playlist <playlist-id>",
+		Short: "Split a playlist into smaller playlists",
+		Long: "Copy the videos of a playlist, in order, into new playlists of --chunk videos each,\n" +
+			"titled \"<title> (part N)\". The source playlist is left unchanged. To resume an\n" +
+			"interrupted split, run it again with --into and the IDs of the parts already created:\n" +
+			"only their missing videos are added.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if chunk < 1 || chunk > maxPlaylistItems {
+				return fmt.Errorf("--chunk must be between 1 and %d", maxPlaylistItems)
+			}
+			if privacy != "" && !slices.Contains(privacyStatuses, privacy) {
+				return fmt.Errorf("invalid --privacy %q (valid: %s)", privacy, strings.Join(privacyStatuses, ", "))
+			}
+			if slices.Contains(into, args[0]) {
+				return fmt.Errorf("a part cannot be the source playlist")
+			}
+			return runSplitPlaylist(cmd.Context(), s, args[0], chunk, privacy, into)
+		},
+	}
+
+	cmd.Flags().IntVar(&chunk, "chunk", 200, "Number of videos per playlist")
+	cmd.Flags().StringVar(&privacy, "privacy", "", "Privacy status of the new playlists (default: the source privacy)")
+	cmd.Flags().StringSliceVar(&into, "into", nil, "IDs of the parts made by an earlier run, in order, to resume it")
+	s = addSafetyFlags(cmd)
+	return cmd
+}
+
+func runSplitPlaylist(ctx context.Context, s *safety, playlistID string, chunk int, privacy string, into []string) error {
+	playlistSvc, err := newManagePlaylistService(ctx, s)
+	if err != nil {
+		return err
+	}
+
+	source, err := playlistSvc.Get(ctx, playlistID)
+	if err != nil {
+		return err
+	}
+	if privacy == "" {
+		privacy = source.Status.PrivacyStatus
+	}
+
+	fmt.Fprintf(os.Stderr, "🔍 Fetching videos from playlist: %s...\n\n", playlistID)
+	items, err := playlistSvc.GetItems(ctx, playlistID, 0)
+	if err != nil {
+		return err
+	}
+
+	videoIDs := availableVideoIDs(items)
+	parts := (len(videoIDs) + chunk - 1) / chunk
+	if len(into) > parts {
+		return fmt.Errorf("--into lists %d part(s), but the playlist splits into %d", len(into), parts)
+	}
+	ok, err := s.confirm("Split %q (%d video(s)) into %d %s playlist(s) of up to %d video(s).",
+		source.Snippet.Title, len(videoIDs), parts, privacy, chunk)
+	if !ok {
+		return err
+	}
+
+	created := slices.Clone(into)
+	for part := 0; part < parts; part++ {
+		title := fmt.Sprintf("%s (part %d)", source.Snippet.Title, part+1)
+		fmt.Fprintf(os.Stderr, "📂 Part %d of %d: %s\n", part+1, parts, title)
+
+		partID := ""
+		if part < len(into) {
+			partID = into[part]
+		}
+		target, err := resumeOrCreatePlaylist(ctx, s, playlistSvc, partID, title, source.Snippet.Description, privacy)
+		if err != nil {
+			return err
+		}
+		if partID == "" {
+			created = append(created, target.Id)
+		}
+
+		end := min((part+1)*chunk, len(videoIDs))
+		if err := copyVideos(ctx, s, playlistSvc, target.Id, videoIDs[part*chunk:end], false); err != nil {
+			return fmt.Errorf("%w\nRun again with --into %s to resume", err, strings.Join(created, ","))
+		}
+		if s.dryRun {
+			continue
+		}
+		fmt.Printf("%s: https://www.youtube.com/playlist?list=%s\n\n", title, target.Id)
+	}
+
+	if !s.dryRun {
+		fmt.Fprintf(os.Stderr, "✅ Split %d video(s) into %d playlist(s)\n", len(videoIDs), parts)
+	}
+	return nil
+}
+
+// newManagePlaylistService returns a playlist service allowed to make changes,
+// or only to read for a dry run.
+func newManagePlaylistService(ctx context.Context, s *safety) (*youtube.PlaylistService, error) {
+	authClient, err := newAuthClient(s.scopes(auth.Manage))
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := authClient.GetYouTubeService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return youtube.NewPlaylistService(service), nil
+}
+
+// resumeOrCreatePlaylist returns the playlist with the given ID, left by an
+// interrupted run, or creates a new playlist when playlistID is empty.
+func resumeOrCreatePlaylist(ctx context.Context, s *safety, playlistSvc *youtube.PlaylistService, playlistID, title, description, privacy string) (*ytapi.Playlist, error) {
+	if playlistID != "" {
+		playlist, err := playlistSvc.Get(ctx, playlistID)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(os.Stderr, "♻️  Resuming playlist %q (%s)\n", playlist.Snippet.Title, playlist.Id)
+		return playlist, nil
+	}
+
+	playlist := &ytapi.Playlist{Id: dryRunPlaylistID}
+	err := s.do(fmt.Sprintf("playlists.insert title=%q privacy=%s", title, privacy), func() error {
+		var err error
+		playlist, err = playlistSvc.Create(ctx, title, description, privacy)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !s.dryRun {
+		fmt.Fprintf(os.Stderr, "📝 Created playlist %q (%s)\n", title, playlist.Id)
+	}
+	return playlist, nil
+}
+
+// availableVideoIDs returns the video IDs of items, leaving out deleted and
+// private videos, which cannot be added to a playlist.
+func availableVideoIDs(items []*ytapi.PlaylistItem) []string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		if !youtube.IsUnavailable(item) {
+			ids = append(ids, item.ContentDetails.VideoId)
+		}
+	}
+	return ids
+}
+
+// copyVideos appends videoIDs to a playlist, skipping those it already holds
+// so that an interrupted copy can be run again. A video listed n times is
+// only added until the playlist holds it n times, or once with dedupe.
+func copyVideos(ctx context.Context, s *safety, playlistSvc *youtube.PlaylistService, playlistID string, videoIDs []string, dedupe bool) error {
+	var existing []*ytapi.PlaylistItem
+	if playlistID != dryRunPlaylistID {
+		var err error
+		existing, err = playlistSvc.GetItems(ctx, playlistID, 0)
+		if err != nil {
+			return err
+		}
+	}
+
+	present := make(map[string]int, len(existing))
+	for _, item := range existing {
+		present[item.ContentDetails.VideoId]++
+	}
+	if len(existing)+len(videoIDs) > maxPlaylistItems {
+		fmt.Fprintf(os.Stderr, "⚠️  Playlist %s may exceed YouTube's limit of %d videos\n", playlistID, maxPlaylistItems)
+	}
+
+	added, skipped := 0, 0
+	seen := make(map[string]bool, len(videoIDs))
+	for i, videoID := range videoIDs {
+		switch {
+		case dedupe && (seen[videoID] || present[videoID] > 0):
+			skipped++
+		case !dedupe && present[videoID] > 0:
+			present[videoID]--
+			skipped++
+		default:
+			err := s.do(fmt.Sprintf("playlistItems.insert playlistId=%s videoId=%s", playlistID, videoID), func() error {
+				return playlistSvc.AddVideo(ctx, playlistID, videoID)
+			})
+			if err != nil {
+				return fmt.Errorf("after adding %d of %d video(s): %w", added, len(videoIDs), err)
+			}
+			added++
+			if !s.dryRun {
+				fmt.Printf("[%d/%d] added %s\n", i+1, len(videoIDs), videoID)
+			}
+		}
+		seen[videoID] = true
+	}
+
+	fmt.Fprintf(os.Stderr, "   %d added, %d already present\n", added, skipped)
+	return nil
+}
@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	ytapi "google.golang.org/api/youtube/v3"
+
+	"youtube-manager/internal/auth"
+	"youtube-manager/internal/youtube"
+)
+
+// createDedupePlaylistCmd creates the dedupe-playlist command.
+func createDedupePlaylistCmd() *cobra.Command {
+	var keep string
+	var across bool
+	var s *safety
+
+	cmd := &cobra.Command{
+		Use:   "dedupe-playlist <playlist-id>...",
+		Short: "Remove duplicate videos from playlists",
+		Long: "Find videos that appear more than once in a playlist and remove the extra occurrences,\n" +
+			"keeping the first or last one. With several playlists, each one is deduplicated on its own\n" +
+			"unless --across is set: then a video is kept only once across all of them, and the order\n" +
+			"of the playlists on the command line decides which occurrence is first.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !slices.Contains(youtube.KeepModes, keep) {
+				return fmt.Errorf("invalid --keep %q (valid: %s)", keep, strings.Join(youtube.KeepModes, ", "))
+			}
+			for i, playlistID := range args {
+				if slices.Contains(args[:i], playlistID) {
+					return fmt.Errorf("playlist %s is given more than once", playlistID)
+				}
+			}
+			return runDedupePlaylist(cmd.Context(), s, args, keep, across)
+		},
+	}
+
+	cmd.Flags().StringVar(&keep, "keep", "first", "Occurrence to keep ("+strings.Join(youtube.KeepModes, ", ")+")")
+	cmd.Flags().BoolVar(&across, "across", false, "Find duplicates across all the given playlists")
+	s = addSafetyFlags(cmd)
+	return cmd
+}
+
+func runDedupePlaylist(ctx context.Context, s *safety, playlistIDs []string, keep string, across bool) error {
+	authClient, err := newAuthClient(s.scopes(auth.Manage))
+	if err != nil {
+		return err
+	}
+
+	service, err := authClient.GetYouTubeService(ctx)
+	if err != nil {
+		return err
+	}
+
+	playlistSvc := youtube.NewPlaylistService(service)
+
+	var groups [][]*ytapi.PlaylistItem
+	for _, playlistID := range playlistIDs {
+		fmt.Fprintf(os.Stderr, "🔍 Fetching videos from playlist: %s...\n", playlistID)
+		items, err := playlistSvc.GetItems(ctx, playlistID, 0)
+		if err != nil {
+			return err
+		}
+		if across && len(groups) > 0 {
+			groups[0] = append(groups[0], items...)
+		} else {
+			groups = append(groups, items)
+		}
+	}
+	fmt.Fprintln(os.Stderr)
+
+	var duplicates []youtube.Duplicate
+	for _, items := range groups {
+		duplicates = append(duplicates, youtube.FindDuplicates(items, keep)...)
+	}
+
+	if len(duplicates) == 0 {
+		fmt.Println("No duplicates found.")
+		return nil
+	}
+
+	total := 0
+	for _, duplicate := range duplicates {
+		kept := duplicate.Kept
+		fmt.Printf("%s (%s)\n", kept.Snippet.Title, kept.ContentDetails.VideoId)
+		fmt.Printf("   keep   %s\n", itemLocation(kept, across))
+		for _, item := range duplicate.Removed {
+			fmt.Printf("   remove %s\n", itemLocation(item, across))
+		}
+		total += len(duplicate.Removed)
+	}
+	fmt.Println()
+
+	if ok, err := s.confirm("Remove %d duplicate(s) of %d video(s).", total, len(duplicates)); !ok {
+		return err
+	}
+
+	removed := 0
+	for _, duplicate := range duplicates {
+		for _, item := range duplicate.Removed {
+			err := s.do("playlistItems.delete id="+item.Id, func() error {
+				return playlistSvc.RemoveVideo(ctx, item.Id)
+			})
+			if err != nil {
+				return fmt.Errorf("after removing %d duplicate(s): %w", removed, err)
+			}
+			removed++
+		}
+	}
+
+	if s.dryRun {
+		fmt.Printf("\n%d duplicate(s) of %d video(s) would be removed\n", removed, len(duplicates))
+		return nil
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Removed %d duplicate(s) of %d video(s)\n", removed, len(duplicates))
+	return nil
+}
+
+// itemLocation describes where an item is, including its playlist when
+// several playlists are compared.
+func itemLocation(item *ytapi.PlaylistItem, withPlaylist bool) string {
+	if withPlaylist {
+		return fmt.Sprintf("position %d in %s", item.Snippet.Position+1, item.Snippet.PlaylistId)
+	}
+	return fmt.Sprintf("position %d", item.Snippet.Position+1)
+}
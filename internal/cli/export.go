@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	ytapi "google.golang.org/api/youtube/v3"
+
+	"youtube-manager/internal/auth"
+	"youtube-manager/internal/export"
+	"youtube-manager/internal/youtube"
+)
+
+// createExportPlaylistCmd creates the export-playlist command.
+func createExportPlaylistCmd() *cobra.Command {
+	var format, output string
+
+	cmd := &cobra.Command{
+		Use:   "export-playlist <playlist-id>",
+		Short: "Export a playlist to JSON, CSV, M3U or YAML",
+		Long: "Export a playlist. Every format uses the same fields: position, video ID, title, channel,\n" +
+			"added-at, duration in seconds and note. M3U output lists watch URLs with #EXTINF durations.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !slices.Contains(export.Formats, format) {
+				return fmt.Errorf("invalid --format %q (valid: %s)", format, strings.Join(export.Formats, ", "))
+			}
+			return runExportPlaylist(cmd.Context(), args[0], format, output)
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "json", "Export format ("+strings.Join(export.Formats, ", ")+")")
+	cmd.Flags().StringVarP(&output, "output", "o", "-", "Output file (- for stdout)")
+	return cmd
+}
+
+func runExportPlaylist(ctx context.Context, playlistID, format, output string) error {
+	authClient, err := newAuthClient(auth.Public)
+	if err != nil {
+		return err
+	}
+
+	service, err := authClient.GetYouTubeService(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "📤 Exporting playlist %s as %s...\n\n", playlistID, format)
+
+	count := 0
+	err = writeOutputFile(output, func(out io.Writer) error {
+		writer, err := export.NewWriter(format, out)
+		if err != nil {
+			return err
+		}
+
+		err = youtube.EachItemWithVideo(ctx, youtube.NewPlaylistService(service), youtube.NewVideoService(service), playlistID, 0,
+			func(item *ytapi.PlaylistItem, video *ytapi.Video) error {
+				count++
+				return writer.Write(newExportEntry(item, video))
+			})
+		if err != nil {
+			return err
+		}
+
+		return writer.Close()
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Exported %d video(s)\n", count)
+	return nil
+}
+
+// writeOutputFile calls write with the file at path, or with stdout if path
+// is "-". The file is removed again if writing or closing it fails, so a
+// failed export leaves no partial file behind.
+func writeOutputFile(path string, write func(out io.Writer) error) error {
+	if path == "-" {
+		return write(os.Stdout)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to create output file: %w", err)
+	}
+
+	err = write(file)
+	if closeErr := file.Close(); closeErr != nil && err == nil {
+		err = fmt.Errorf("unable to write output file: %w", closeErr)
+	}
+	if err != nil {
+		os.Remove(path)
+		return err
+	}
+	return nil
+}
+
+// newExportEntry converts a playlist item and its video details, which may be
+// nil for deleted or private videos, to an export entry.
+func newExportEntry(item *ytapi.PlaylistItem, video *ytapi.Video) export.Entry {
+	entry := export.Entry{
+		Position: int(item.Snippet.Position) + 1,
+		VideoID:  item.ContentDetails.VideoId,
+		Title:    item.Snippet.Title,
+		Channel:  item.Snippet.VideoOwnerChannelTitle,
+		AddedAt:  item.Snippet.PublishedAt,
+		Note:     item.ContentDetails.Note,
+	}
+
+	if video != nil && video.ContentDetails != nil {
+		if duration, err := youtube.ParseDuration(video.ContentDetails.Duration); err == nil {
+			entry.DurationSeconds = int64(duration.Seconds())
+		}
+	}
+
+	return entry
+}
@@ -4,20 +4,41 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	ytapi "google.golang.org/api/youtube/v3"
 
 	"youtube-manager/internal/auth"
+	"youtube-manager/internal/export"
 	"youtube-manager/internal/youtube"
 )
 
+// privacyStatuses lists the valid playlist privacy statuses.
+var privacyStatuses = []string{"private", "public", "unlisted"}
+
 // registerPlaylistCommands adds playlist-related commands to the root command.
 func registerPlaylistCommands() {
 	rootCmd.AddCommand(createListPlaylistsCmd())
 	rootCmd.AddCommand(createGetPlaylistCmd())
 	rootCmd.AddCommand(createCreatePlaylistCmd())
+	rootCmd.AddCommand(createUpdatePlaylistCmd())
 	rootCmd.AddCommand(createDeletePlaylistCmd())
 	rootCmd.AddCommand(createAddToPlaylistCmd())
+	rootCmd.AddCommand(createRemoveFromPlaylistCmd())
+	rootCmd.AddCommand(createReorderVideoCmd())
+	rootCmd.AddCommand(createMoveVideoCmd())
+	rootCmd.AddCommand(createSortPlaylistCmd())
+	rootCmd.AddCommand(createExportPlaylistCmd())
+	rootCmd.AddCommand(createSyncPlaylistCmd())
+	rootCmd.AddCommand(createDedupePlaylistCmd())
+	rootCmd.AddCommand(createCheckPlaylistCmd())
+	rootCmd.AddCommand(createMergePlaylistsCmd())
+	rootCmd.AddCommand(createClonePlaylistCmd())
+	rootCmd.AddCommand(createSplitPlaylistCmd())
+	rootCmd.AddCommand(createPlaylistStatsCmd())
 }
 
 // createListPlaylistsCmd creates the list-playlists command.
@@ -32,12 +53,12 @@ func createListPlaylistsCmd() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().IntVar(&limit, "limit", 50, "Maximum number of playlists to return")
+	cmd.Flags().IntVar(&limit, "limit", 50, "Maximum number of playlists to return (0 for all)")
 	return cmd
 }
 
 func runListPlaylists(ctx context.Context, limit int) error {
-	authClient, err := auth.NewClient()
+	authClient, err := newAuthClient(auth.ReadOnly)
 	if err != nil {
 		return err
 	}
@@ -72,12 +93,12 @@ func createGetPlaylistCmd() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().IntVar(&limit, "limit", 50, "Maximum number of videos to return")
+	cmd.Flags().IntVar(&limit, "limit", 50, "Maximum number of videos to return (0 for all)")
 	return cmd
 }
 
 func runGetPlaylist(ctx context.Context, playlistID string, limit int) error {
-	authClient, err := auth.NewClient()
+	authClient, err := newAuthClient(auth.Public)
 	if err != nil {
 		return err
 	}
@@ -89,36 +110,53 @@ func runGetPlaylist(ctx context.Context, playlistID string, limit int) error {
 
 	fmt.Fprintf(os.Stderr, "🔍 Fetching videos from playlist: %s...\n\n", playlistID)
 
+	// Print items as pages arrive instead of buffering large playlists.
 	playlistSvc := youtube.NewPlaylistService(service)
-	items, err := playlistSvc.GetItems(ctx, playlistID, limit)
+	count := 0
+	err = playlistSvc.EachItem(ctx, playlistID, limit, func(item *ytapi.PlaylistItem) error {
+		count++
+		youtube.PrintPlaylistItem(count, item)
+		return nil
+	})
 	if err != nil {
 		return err
 	}
 
-	youtube.PrintPlaylistItems(items)
+	if count == 0 {
+		fmt.Println("No videos found in this playlist.")
+		return nil
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Found %d video(s)\n", count)
 	return nil
 }
 
 // createCreatePlaylistCmd creates the create-playlist command.
 func createCreatePlaylistCmd() *cobra.Command {
 	var description, privacy string
+	var s *safety
 
 	cmd := &cobra.Command{
 		Use:   "create-playlist <title>",
 		Short: "Create a new playlist",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runCreatePlaylist(cmd.Context(), args[0], description, privacy)
+			return runCreatePlaylist(cmd.Context(), s, args[0], description, privacy)
 		},
 	}
 
 	cmd.Flags().StringVar(&description, "description", "", "Playlist description")
 	cmd.Flags().StringVar(&privacy, "privacy", "private", "Privacy status (private, public, unlisted)")
+	s = addSafetyFlags(cmd)
 	return cmd
 }
 
-func runCreatePlaylist(ctx context.Context, title, description, privacy string) error {
-	authClient, err := auth.NewClient()
+func runCreatePlaylist(ctx context.Context, s *safety, title, description, privacy string) error {
+	if ok, err := s.confirm("Create %s playlist %q.", privacy, title); !ok {
+		return err
+	}
+
+	authClient, err := newAuthClient(s.scopes(auth.Manage))
 	if err != nil {
 		return err
 	}
@@ -131,8 +169,12 @@ func runCreatePlaylist(ctx context.Context, title, description, privacy string)
 	fmt.Fprintf(os.Stderr, "📝 Creating playlist: %s...\n\n", title)
 
 	playlistSvc := youtube.NewPlaylistService(service)
-	playlist, err := playlistSvc.Create(ctx, title, description, privacy)
-	if err != nil {
+	var playlist *ytapi.Playlist
+	err = s.do(fmt.Sprintf("playlists.insert title=%q privacy=%s", title, privacy), func() error {
+		playlist, err = playlistSvc.Create(ctx, title, description, privacy)
+		return err
+	})
+	if err != nil || s.dryRun {
 		return err
 	}
 
@@ -143,20 +185,132 @@ func runCreatePlaylist(ctx context.Context, title, description, privacy string)
 	return nil
 }
 
+// createUpdatePlaylistCmd creates the update-playlist command.
+func createUpdatePlaylistCmd() *cobra.Command {
+	var title, description, privacy, language string
+	var s *safety
+
+	cmd := &cobra.Command{
+		Use:   "update-playlist <playlist-id>",
+		Short: "Update a playlist's title, description, privacy or default language",
+		Long:  "Update a playlist. Fields whose flags are not given keep their current values.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var update youtube.PlaylistUpdate
+			flags := cmd.Flags()
+			if flags.Changed("title") {
+				if title == "" {
+					return fmt.Errorf("--title cannot be empty")
+				}
+				update.Title = &title
+			}
+			if flags.Changed("description") {
+				update.Description = &description
+			}
+			if flags.Changed("privacy") {
+				if !slices.Contains(privacyStatuses, privacy) {
+					return fmt.Errorf("invalid --privacy %q (valid: %s)", privacy, strings.Join(privacyStatuses, ", "))
+				}
+				update.Privacy = &privacy
+			}
+			if flags.Changed("default-language") {
+				update.DefaultLanguage = &language
+			}
+			if update == (youtube.PlaylistUpdate{}) {
+				return fmt.Errorf("nothing to update: set at least one of --title, --description, --privacy, --default-language")
+			}
+			return runUpdatePlaylist(cmd.Context(), s, args[0], update)
+		},
+	}
+
+	cmd.Flags().StringVar(&title, "title", "", "New playlist title")
+	cmd.Flags().StringVar(&description, "description", "", "New playlist description")
+	cmd.Flags().StringVar(&privacy, "privacy", "", "New privacy status (private, public, unlisted)")
+	cmd.Flags().StringVar(&language, "default-language", "", "New default language (BCP-47 code, e.g. en or fr-CA)")
+	s = addSafetyFlags(cmd)
+	return cmd
+}
+
+func runUpdatePlaylist(ctx context.Context, s *safety, playlistID string, update youtube.PlaylistUpdate) error {
+	authClient, err := newAuthClient(s.scopes(auth.Manage))
+	if err != nil {
+		return err
+	}
+
+	service, err := authClient.GetYouTubeService(ctx)
+	if err != nil {
+		return err
+	}
+
+	playlistSvc := youtube.NewPlaylistService(service)
+	current, err := playlistSvc.Get(ctx, playlistID)
+	if err != nil {
+		return err
+	}
+
+	if ok, err := s.confirm("Update playlist %q:\n%s", current.Snippet.Title, describeUpdate(current, update)); !ok {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✏️  Updating playlist: %s...\n\n", playlistID)
+
+	var playlist *ytapi.Playlist
+	err = s.do(fmt.Sprintf("playlists.update id=%s part=snippet,status", playlistID), func() error {
+		playlist, err = playlistSvc.Update(ctx, current, update)
+		return err
+	})
+	if err != nil || s.dryRun {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Playlist updated successfully!\n")
+	fmt.Printf("   Title: %s\n", playlist.Snippet.Title)
+	fmt.Printf("   Privacy: %s\n", playlist.Status.PrivacyStatus)
+	if playlist.Snippet.DefaultLanguage != "" {
+		fmt.Printf("   Default language: %s\n", playlist.Snippet.DefaultLanguage)
+	}
+	fmt.Printf("   Link: https://www.youtube.com/playlist?list=%s\n", playlist.Id)
+
+	return nil
+}
+
+// describeUpdate lists the fields an update changes, one per line.
+func describeUpdate(current *ytapi.Playlist, update youtube.PlaylistUpdate) string {
+	var lines []string
+	if update.Title != nil {
+		lines = append(lines, fmt.Sprintf("   title: %q → %q", current.Snippet.Title, *update.Title))
+	}
+	if update.Description != nil {
+		lines = append(lines, "   description: replaced")
+	}
+	if update.Privacy != nil {
+		lines = append(lines, fmt.Sprintf("   privacy: %s → %s", current.Status.PrivacyStatus, *update.Privacy))
+	}
+	if update.DefaultLanguage != nil {
+		lines = append(lines, fmt.Sprintf("   default language: %q → %q", current.Snippet.DefaultLanguage, *update.DefaultLanguage))
+	}
+	return strings.Join(lines, "\n")
+}
+
 // createDeletePlaylistCmd creates the delete-playlist command.
 func createDeletePlaylistCmd() *cobra.Command {
-	return &cobra.Command{
+	var s *safety
+
+	cmd := &cobra.Command{
 		Use:   "delete-playlist <playlist-id>",
 		Short: "Delete a playlist",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runDeletePlaylist(cmd.Context(), args[0])
+			return runDeletePlaylist(cmd.Context(), s, args[0])
 		},
 	}
+
+	s = addSafetyFlags(cmd)
+	return cmd
 }
 
-func runDeletePlaylist(ctx context.Context, playlistID string) error {
-	authClient, err := auth.NewClient()
+func runDeletePlaylist(ctx context.Context, s *safety, playlistID string) error {
+	authClient, err := newAuthClient(s.scopes(auth.Manage))
 	if err != nil {
 		return err
 	}
@@ -166,10 +320,24 @@ func runDeletePlaylist(ctx context.Context, playlistID string) error {
 		return err
 	}
 
+	playlistSvc := youtube.NewPlaylistService(service)
+	playlist, err := playlistSvc.Get(ctx, playlistID)
+	if err != nil {
+		return err
+	}
+
+	ok, err := s.confirm("Delete playlist %q (%s) and its %d video(s). This cannot be undone.",
+		playlist.Snippet.Title, playlistID, playlist.ContentDetails.ItemCount)
+	if !ok {
+		return err
+	}
+
 	fmt.Fprintf(os.Stderr, "🗑️  Deleting playlist: %s...\n\n", playlistID)
 
-	playlistSvc := youtube.NewPlaylistService(service)
-	if err := playlistSvc.Delete(ctx, playlistID); err != nil {
+	err = s.do("playlists.delete id="+playlistID, func() error {
+		return playlistSvc.Delete(ctx, playlistID)
+	})
+	if err != nil || s.dryRun {
 		return err
 	}
 
@@ -179,18 +347,183 @@ func runDeletePlaylist(ctx context.Context, playlistID string) error {
 
 // createAddToPlaylistCmd creates the add-to-playlist command.
 func createAddToPlaylistCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:   "add-to-playlist <playlist-id> <video-id>",
-		Short: "Add a video to a playlist",
-		Args:  cobra.ExactArgs(2),
+	var file string
+	var position int
+	var s *safety
+
+	cmd := &cobra.Command{
+		Use:   "add-to-playlist <playlist-id> [video-id|url]...",
+		Short: "Add videos to a playlist",
+		Long: "Add videos, given as IDs or URLs, to a playlist. Videos are read from the arguments,\n" +
+			"from --file (YAML, JSON, CSV or text), or one per line from stdin when neither is given.\n" +
+			"Videos already in the playlist are skipped.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if position < 0 {
+				return fmt.Errorf("--position must be 1 or more")
+			}
+			values, err := videoArgs(args[1:], file)
+			if err != nil {
+				return err
+			}
+			return runAddToPlaylist(cmd.Context(), s, args[0], values, position)
+		},
+	}
+
+	cmd.Flags().StringVar(&file, "file", "", "File listing the videos (.yaml, .json, .csv, or text; - for stdin)")
+	cmd.Flags().IntVar(&position, "position", 0, "1-based position of the first added video (default: append)")
+	s = addSafetyFlags(cmd)
+	return cmd
+}
+
+// videoArgs returns the videos given as arguments, in a file, or on stdin
+// when there are neither.
+func videoArgs(args []string, file string) ([]string, error) {
+	values := slices.Clone(args)
+	if file == "" && len(args) == 0 {
+		if term.IsTerminal(int(os.Stdin.Fd())) {
+			return nil, fmt.Errorf("no videos given: pass IDs or URLs, --file, or pipe them on stdin")
+		}
+		file = "-"
+	}
+
+	if file != "" {
+		spec, err := export.ReadSpecFile(file)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, spec.Videos...)
+	}
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no videos given")
+	}
+	return values, nil
+}
+
+func runAddToPlaylist(ctx context.Context, s *safety, playlistID string, values []string, position int) error {
+	authClient, err := newAuthClient(s.scopes(auth.Manage))
+	if err != nil {
+		return err
+	}
+
+	service, err := authClient.GetYouTubeService(ctx)
+	if err != nil {
+		return err
+	}
+
+	playlistSvc := youtube.NewPlaylistService(service)
+	playlist, err := playlistSvc.Get(ctx, playlistID)
+	if err != nil {
+		return err
+	}
+
+	items, err := playlistSvc.GetItems(ctx, playlistID, 0)
+	if err != nil {
+		return err
+	}
+
+	present := make(map[string]bool, len(items))
+	for _, item := range items {
+		present[item.ContentDetails.VideoId] = true
+	}
+
+	skipped, failed := 0, 0
+	var videoIDs []string
+	for _, value := range values {
+		videoID, err := youtube.ParseVideoID(value)
+		switch {
+		case err != nil:
+			fmt.Printf("❌ %v\n", err)
+			failed++
+		case present[videoID]:
+			fmt.Printf("⏭️  %s: already in playlist\n", videoID)
+			skipped++
+		default:
+			present[videoID] = true
+			videoIDs = append(videoIDs, videoID)
+		}
+	}
+
+	added := 0
+	if len(videoIDs) > 0 {
+		ok, err := s.confirm("Add %d video(s) to playlist %q (%d video(s)).", len(videoIDs), playlist.Snippet.Title, len(items))
+		if !ok {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "➕ Adding %d video(s) to playlist %s...\n\n", len(videoIDs), playlistID)
+
+		// Positions are 0-based in the API; each added video shifts the next one.
+		next := int64(position) - 1
+		for _, videoID := range videoIDs {
+			call := fmt.Sprintf("playlistItems.insert playlistId=%s videoId=%s", playlistID, videoID)
+			if next >= 0 {
+				call += fmt.Sprintf(" position=%d", next)
+			}
+
+			err := s.do(call, func() error {
+				_, err := playlistSvc.InsertVideo(ctx, playlistID, videoID, next)
+				return err
+			})
+			if err != nil {
+				fmt.Printf("❌ %s: %v\n", videoID, err)
+				failed++
+				continue
+			}
+			if !s.dryRun {
+				fmt.Printf("✅ %s: added\n", videoID)
+			}
+			added++
+			if next >= 0 {
+				next++
+			}
+		}
+	}
+
+	if s.dryRun {
+		fmt.Printf("\n%d would be added, %d skipped, %d failed\n", added, skipped, failed)
+	} else {
+		fmt.Printf("\n%d added, %d skipped, %d failed\n", added, skipped, failed)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d video(s) could not be added", failed)
+	}
+	return nil
+}
+
+// createRemoveFromPlaylistCmd creates the remove-from-playlist command.
+func createRemoveFromPlaylistCmd() *cobra.Command {
+	var position int
+	var all bool
+	var s *safety
+
+	cmd := &cobra.Command{
+		Use:   "remove-from-playlist <playlist-id> [video-id]",
+		Short: "Remove a video from a playlist",
+		Long: "Remove a video from a playlist, identified by its video ID or by its 1-based position.\n" +
+			"If the video appears more than once, only the first occurrence is removed unless --all is set.",
+		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runAddToPlaylist(cmd.Context(), args[0], args[1])
+			videoID := ""
+			if len(args) == 2 {
+				videoID = args[1]
+			}
+			if (videoID == "") == (position == 0) {
+				return fmt.Errorf("specify either a video ID or --position")
+			}
+			return runRemoveFromPlaylist(cmd.Context(), s, args[0], videoID, position, all)
 		},
 	}
+
+	cmd.Flags().IntVar(&position, "position", 0, "1-based position of the item to remove")
+	cmd.Flags().BoolVar(&all, "all", false, "Remove every occurrence of the video")
+	s = addSafetyFlags(cmd)
+	return cmd
 }
 
-func runAddToPlaylist(ctx context.Context, playlistID, videoID string) error {
-	authClient, err := auth.NewClient()
+func runRemoveFromPlaylist(ctx context.Context, s *safety, playlistID, videoID string, position int, all bool) error {
+	authClient, err := newAuthClient(s.scopes(auth.Manage))
 	if err != nil {
 		return err
 	}
@@ -200,13 +533,214 @@ func runAddToPlaylist(ctx context.Context, playlistID, videoID string) error {
 		return err
 	}
 
-	fmt.Fprintf(os.Stderr, "➕ Adding video %s to playlist %s...\n\n", videoID, playlistID)
+	playlistSvc := youtube.NewPlaylistService(service)
+	items, err := lookupItems(ctx, playlistSvc, playlistID, videoID, position)
+	if err != nil {
+		return err
+	}
+	if len(items) > 1 && !all {
+		fmt.Fprintf(os.Stderr, "⚠️  Video appears %d times; removing the first occurrence (use --all to remove every one)\n\n", len(items))
+		items = items[:1]
+	}
+
+	if ok, err := s.confirm("Remove %s from playlist %s.", describeItems(items), playlistID); !ok {
+		return err
+	}
+
+	for _, item := range items {
+		err := s.do("playlistItems.delete id="+item.Id, func() error {
+			return playlistSvc.RemoveVideo(ctx, item.Id)
+		})
+		if err != nil {
+			return err
+		}
+		if !s.dryRun {
+			fmt.Printf("Removed: %d. %s (item ID: %s)\n", item.Snippet.Position+1, item.Snippet.Title, item.Id)
+		}
+	}
+
+	if !s.dryRun {
+		fmt.Fprintf(os.Stderr, "\n✅ Removed %d item(s) from playlist successfully!\n", len(items))
+	}
+	return nil
+}
+
+// describeItems names the items about to change for a confirmation prompt.
+func describeItems(items []*ytapi.PlaylistItem) string {
+	if len(items) == 1 {
+		return fmt.Sprintf("%q at position %d", items[0].Snippet.Title, items[0].Snippet.Position+1)
+	}
+	return fmt.Sprintf("%d occurrences of %q", len(items), items[0].Snippet.Title)
+}
+
+// lookupItems resolves playlist items either by 1-based position or by video
+// ID, in which case every occurrence of the video is returned.
+func lookupItems(ctx context.Context, playlistSvc *youtube.PlaylistService, playlistID, videoID string, position int) ([]*ytapi.PlaylistItem, error) {
+	if position > 0 {
+		fmt.Fprintf(os.Stderr, "🔍 Looking up position %d in playlist %s...\n\n", position, playlistID)
+		item, err := playlistSvc.ItemAt(ctx, playlistID, position)
+		if err != nil {
+			return nil, err
+		}
+		return []*ytapi.PlaylistItem{item}, nil
+	}
+
+	fmt.Fprintf(os.Stderr, "🔍 Looking up video %s in playlist %s...\n\n", videoID, playlistID)
+	items, err := playlistSvc.FindVideo(ctx, playlistID, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("video %s is not in playlist %s", videoID, playlistID)
+	}
+
+	return items, nil
+}
+
+// createReorderVideoCmd creates the reorder-video command.
+func createReorderVideoCmd() *cobra.Command {
+	var from, to int
+	var s *safety
+
+	cmd := &cobra.Command{
+		Use:   "reorder-video <playlist-id> [video-id]",
+		Short: "Move a video to another position within a playlist",
+		Long: "Move a video, identified by its video ID or by its 1-based position (--from), to the 1-based position --to.\n" +
+			"If the video appears more than once, its first occurrence is moved.",
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			videoID := ""
+			if len(args) == 2 {
+				videoID = args[1]
+			}
+			if (videoID == "") == (from == 0) {
+				return fmt.Errorf("specify either a video ID or --from")
+			}
+			if to < 1 {
+				return fmt.Errorf("--to must be a position starting at 1")
+			}
+			return runReorderVideo(cmd.Context(), s, args[0], videoID, from, to)
+		},
+	}
+
+	cmd.Flags().IntVar(&from, "from", 0, "1-based position of the item to move")
+	cmd.Flags().IntVar(&to, "to", 0, "1-based position to move the item to")
+	cmd.MarkFlagRequired("to")
+	s = addSafetyFlags(cmd)
+	return cmd
+}
+
+func runReorderVideo(ctx context.Context, s *safety, playlistID, videoID string, from, to int) error {
+	authClient, err := newAuthClient(s.scopes(auth.Manage))
+	if err != nil {
+		return err
+	}
+
+	service, err := authClient.GetYouTubeService(ctx)
+	if err != nil {
+		return err
+	}
 
 	playlistSvc := youtube.NewPlaylistService(service)
-	if err := playlistSvc.AddVideo(ctx, playlistID, videoID); err != nil {
+	items, err := lookupItems(ctx, playlistSvc, playlistID, videoID, from)
+	if err != nil {
+		return err
+	}
+	item := items[0]
+
+	if ok, err := s.confirm("Move %s to position %d.", describeItems(items[:1]), to); !ok {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "↕️  Moving %s from position %d to %d...\n\n", item.Snippet.Title, item.Snippet.Position+1, to)
+
+	err = s.do(fmt.Sprintf("playlistItems.update id=%s position=%d", item.Id, to-1), func() error {
+		return playlistSvc.MoveItem(ctx, item, int64(to-1))
+	})
+	if err != nil || s.dryRun {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Video moved successfully!\n")
+	return nil
+}
+
+// createMoveVideoCmd creates the move-video command.
+func createMoveVideoCmd() *cobra.Command {
+	var position int
+	var s *safety
+
+	cmd := &cobra.Command{
+		Use:   "move-video <source-playlist-id> <target-playlist-id> <video-id>",
+		Short: "Move a video from one playlist to another",
+		Long: "Move a video from one playlist to another, inserting it at a 1-based --position or appending it.\n" +
+			"If removing the video from the source playlist fails, the insertion is rolled back.",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if position < 0 {
+				return fmt.Errorf("--position must start at 1")
+			}
+			return runMoveVideo(cmd.Context(), s, args[0], args[1], args[2], position)
+		},
+	}
+
+	cmd.Flags().IntVar(&position, "position", 0, "1-based position in the target playlist (default: append)")
+	s = addSafetyFlags(cmd)
+	return cmd
+}
+
+func runMoveVideo(ctx context.Context, s *safety, sourceID, targetID, videoID string, position int) error {
+	authClient, err := newAuthClient(s.scopes(auth.Manage))
+	if err != nil {
 		return err
 	}
 
-	fmt.Fprintf(os.Stderr, "✅ Video added to playlist successfully!\n")
+	service, err := authClient.GetYouTubeService(ctx)
+	if err != nil {
+		return err
+	}
+
+	playlistSvc := youtube.NewPlaylistService(service)
+	items, err := lookupItems(ctx, playlistSvc, sourceID, videoID, 0)
+	if err != nil {
+		return err
+	}
+	source := items[0]
+
+	if ok, err := s.confirm("Move %q from playlist %s to playlist %s.", source.Snippet.Title, sourceID, targetID); !ok {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "🔀 Moving %s to playlist %s...\n\n", source.Snippet.Title, targetID)
+
+	call := fmt.Sprintf("playlistItems.insert playlistId=%s videoId=%s", targetID, videoID)
+	if position > 0 {
+		call += fmt.Sprintf(" position=%d", position-1)
+	}
+
+	var inserted *ytapi.PlaylistItem
+	err = s.do(call, func() error {
+		inserted, err = playlistSvc.InsertVideo(ctx, targetID, videoID, int64(position-1))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.do("playlistItems.delete id="+source.Id, func() error {
+		return playlistSvc.RemoveVideo(ctx, source.Id)
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Could not remove the video from the source playlist, rolling back...\n")
+		if rollbackErr := playlistSvc.RemoveVideo(ctx, inserted.Id); rollbackErr != nil {
+			return fmt.Errorf("%w; rollback also failed, video is now in both playlists: %v", err, rollbackErr)
+		}
+		return fmt.Errorf("%w; the video was left in the source playlist", err)
+	}
+	if s.dryRun {
+		return nil
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Video moved successfully!\n")
 	return nil
 }
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"youtube-manager/internal/auth"
+)
+
+// errConfirmationRequired is returned when a mutating command needs
+// confirmation but there is no terminal to ask on.
+var errConfirmationRequired = errors.New("confirmation required: run in a terminal or pass --yes")
+
+// safety holds the --yes and --dry-run flags shared by mutating commands.
+// Commands that already print a plan and need --apply (sync-playlist,
+// restore) do not use it.
+type safety struct {
+	yes    bool
+	dryRun bool
+}
+
+// addSafetyFlags adds --yes and --dry-run to a mutating command.
+func addSafetyFlags(cmd *cobra.Command) *safety {
+	s := &safety{}
+	cmd.Flags().BoolVarP(&s.yes, "yes", "y", false, "Do not ask for confirmation")
+	cmd.Flags().BoolVar(&s.dryRun, "dry-run", false, "Print the API calls that would be made without making them")
+	return s
+}
+
+// scopes returns the scope set to authenticate with: a dry run only reads.
+func (s *safety) scopes(scopes auth.ScopeSet) auth.ScopeSet {
+	if s.dryRun {
+		return auth.ReadOnly
+	}
+	return scopes
+}
+
+// confirm shows what is about to change and asks whether to go on. It returns
+// false, with a nil error, if the user declines. Dry runs and --yes never
+// ask; without a terminal, --yes is required.
+func (s *safety) confirm(format string, args ...any) (bool, error) {
+	if s.yes || s.dryRun {
+		return true, nil
+	}
+
+	tty, err := openTerminal()
+	if err != nil {
+		return false, errConfirmationRequired
+	}
+	defer tty.Close()
+
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	fmt.Fprintf(os.Stderr, "Proceed? [y/N] ")
+
+	answer, err := bufio.NewReader(tty).ReadString('\n')
+	if err != nil && answer == "" {
+		return false, fmt.Errorf("unable to read confirmation: %w", err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		fmt.Fprintln(os.Stderr)
+		return true, nil
+	default:
+		fmt.Fprintf(os.Stderr, "Cancelled.\n")
+		return false, nil
+	}
+}
+
+// do makes an API call, described as call, or only prints the description
+// on a dry run.
+func (s *safety) do(call string, fn func() error) error {
+	if s.dryRun {
+		fmt.Printf("[dry-run] %s\n", call)
+		return nil
+	}
+	return fn()
+}
+
+// openTerminal opens the terminal to read a confirmation from. Stdin is used
+// when it is a terminal; otherwise, such as when videos are piped in, the
+// controlling terminal is opened directly.
+func openTerminal() (io.ReadCloser, error) {
+	if term.IsTerminal(int(os.Stdin.Fd())) {
+		return io.NopCloser(os.Stdin), nil
+	}
+	return os.Open("/dev/tty")
+}
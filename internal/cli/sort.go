@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	ytapi "google.golang.org/api/youtube/v3"
+
+	"youtube-manager/internal/auth"
+	"youtube-manager/internal/youtube"
+)
+
+// createSortPlaylistCmd creates the sort-playlist command.
+func createSortPlaylistCmd() *cobra.Command {
+	var by string
+	var desc, preview bool
+	var s *safety
+
+	cmd := &cobra.Command{
+		Use:   "sort-playlist <playlist-id>",
+		Short: "Sort a playlist by title, publish date, duration, views or channel",
+		Long: "Sort a playlist in place. Only the items that are out of order are moved.\n" +
+			"Deleted and private videos are kept at the end.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !slices.Contains(youtube.SortFields, by) {
+				return fmt.Errorf("invalid --by %q (valid: %s)", by, strings.Join(youtube.SortFields, ", "))
+			}
+			return runSortPlaylist(cmd.Context(), s, args[0], by, desc, preview)
+		},
+	}
+
+	cmd.Flags().StringVar(&by, "by", "title", "Sort field ("+strings.Join(youtube.SortFields, ", ")+")")
+	cmd.Flags().BoolVar(&desc, "desc", false, "Sort in descending order")
+	cmd.Flags().BoolVar(&preview, "preview", false, "Print the current and sorted order without changing anything")
+	s = addSafetyFlags(cmd)
+	return cmd
+}
+
+func runSortPlaylist(ctx context.Context, s *safety, playlistID, by string, desc, preview bool) error {
+	scopes := s.scopes(auth.Manage)
+	if preview {
+		scopes = auth.Public
+	}
+
+	authClient, err := newAuthClient(scopes)
+	if err != nil {
+		return err
+	}
+
+	service, err := authClient.GetYouTubeService(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "🔍 Fetching videos from playlist: %s...\n\n", playlistID)
+
+	playlistSvc := youtube.NewPlaylistService(service)
+	items, err := playlistSvc.GetItems(ctx, playlistID, 0)
+	if err != nil {
+		return err
+	}
+
+	videoIDs := make([]string, len(items))
+	for i, item := range items {
+		videoIDs[i] = item.ContentDetails.VideoId
+	}
+
+	videos, err := youtube.NewVideoService(service).GetDetails(ctx, videoIDs)
+	if err != nil {
+		return err
+	}
+
+	sorted, err := youtube.SortItems(items, videos, by, desc)
+	if err != nil {
+		return err
+	}
+
+	moves := youtube.PlanMoves(items, sorted)
+
+	if preview {
+		printOrder("Current order", items)
+		printOrder("Sorted order", sorted)
+		fmt.Fprintf(os.Stderr, "ℹ️  Sorting would move %d of %d item(s)\n", len(moves), len(items))
+		return nil
+	}
+
+	if len(moves) == 0 {
+		fmt.Fprintf(os.Stderr, "✅ Playlist is already sorted\n")
+		return nil
+	}
+
+	if ok, err := s.confirm("Sort playlist %s by %s, moving %d of %d item(s).", playlistID, by, len(moves), len(items)); !ok {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "↕️  Moving %d of %d item(s)...\n\n", len(moves), len(items))
+	for i, move := range moves {
+		err := s.do(fmt.Sprintf("playlistItems.update id=%s position=%d", move.Item.Id, move.Position), func() error {
+			return playlistSvc.MoveItem(ctx, move.Item, move.Position)
+		})
+		if err != nil {
+			return fmt.Errorf("after %d of %d move(s): %w", i, len(moves), err)
+		}
+		if !s.dryRun {
+			fmt.Printf("[%d/%d] %s -> position %d\n", i+1, len(moves), move.Item.Snippet.Title, move.Position+1)
+		}
+	}
+	if s.dryRun {
+		return nil
+	}
+
+	fmt.Fprintf(os.Stderr, "\n✅ Playlist sorted successfully!\n")
+	return nil
+}
+
+// printOrder prints a numbered list of playlist item titles under a heading.
+func printOrder(heading string, items []*ytapi.PlaylistItem) {
+	fmt.Printf("%s:\n", heading)
+	for i, item := range items {
+		fmt.Printf("%4d. %s\n", i+1, item.Snippet.Title)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	ytapi "google.golang.org/api/youtube/v3"
+
+	"youtube-manager/internal/auth"
+	"youtube-manager/internal/youtube"
+)
+
+// histogramWidth is the length of the longest bar of the year histogram.
+const histogramWidth = 40
+
+// createPlaylistStatsCmd creates the playlist-stats command.
+func createPlaylistStatsCmd() *cobra.Command {
+	var format string
+	var top int
+
+	cmd := &cobra.Command{
+		Use:   "playlist-stats <playlist-id>",
+		Short: "Show runtime, channel and publish-year statistics for a playlist",
+		Long: "Report the total and average runtime, total views, top channels, number of videos\n" +
+			"per publish year, and the longest and shortest videos of a playlist.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != "table" && format != "json" {
+				return fmt.Errorf("invalid --format %q (valid: table, json)", format)
+			}
+			return runPlaylistStats(cmd.Context(), args[0], format, top)
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "table", "Output format (table, json)")
+	cmd.Flags().IntVar(&top, "top", 10, "Number of channels to list (0 for all)")
+	return cmd
+}
+
+func runPlaylistStats(ctx context.Context, playlistID, format string, top int) error {
+	authClient, err := newAuthClient(auth.Public)
+	if err != nil {
+		return err
+	}
+
+	service, err := authClient.GetYouTubeService(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "📊 Computing statistics for playlist: %s...\n\n", playlistID)
+
+	var stats youtube.PlaylistStats
+	err = youtube.EachItemWithVideo(ctx, youtube.NewPlaylistService(service), youtube.NewVideoService(service), playlistID, 0,
+		func(item *ytapi.PlaylistItem, video *ytapi.Video) error {
+			stats.Add(item, video)
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+	stats.Finish(top)
+
+	if format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(stats)
+	}
+
+	printPlaylistStats(&stats)
+	return nil
+}
+
+// printPlaylistStats prints statistics as aligned tables.
+func printPlaylistStats(stats *youtube.PlaylistStats) {
+	fmt.Printf("%-16s %d", "Videos:", stats.Items)
+	if stats.Unavailable > 0 {
+		fmt.Printf(" (%d deleted or private)", stats.Unavailable)
+	}
+	fmt.Println()
+	fmt.Printf("%-16s %s\n", "Total runtime:", formatSeconds(stats.TotalSeconds))
+	fmt.Printf("%-16s %s\n", "Average runtime:", formatSeconds(stats.AverageSeconds))
+	fmt.Printf("%-16s %d\n", "Total views:", stats.TotalViews)
+	if stats.Longest != nil {
+		fmt.Printf("%-16s %s  %s (%s)\n", "Longest:", formatSeconds(stats.Longest.DurationSeconds), stats.Longest.Title, stats.Longest.VideoID)
+		fmt.Printf("%-16s %s  %s (%s)\n", "Shortest:", formatSeconds(stats.Shortest.DurationSeconds), stats.Shortest.Title, stats.Shortest.VideoID)
+	}
+
+	if len(stats.TopChannels) > 0 {
+		fmt.Printf("\nTop channels:\n")
+		for _, channel := range stats.TopChannels {
+			fmt.Printf("%6d  %s\n", channel.Videos, channel.Channel)
+		}
+	}
+
+	if len(stats.Years) > 0 {
+		most := 0
+		for _, year := range stats.Years {
+			most = max(most, year.Videos)
+		}
+
+		fmt.Printf("\nVideos by publish year:\n")
+		for _, year := range stats.Years {
+			bar := max(1, year.Videos*histogramWidth/most)
+			fmt.Printf("  %d %6d  %s\n", year.Year, year.Videos, strings.Repeat("█", bar))
+		}
+	}
+}
+
+// formatSeconds formats a number of seconds as H:MM:SS.
+func formatSeconds(seconds int64) string {
+	return youtube.FormatDuration(time.Duration(seconds) * time.Second)
+}
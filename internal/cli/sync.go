@@ -0,0 +1,259 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	ytapi "google.golang.org/api/youtube/v3"
+
+	"youtube-manager/internal/auth"
+	"youtube-manager/internal/export"
+	"youtube-manager/internal/youtube"
+)
+
+// createSyncPlaylistCmd creates the sync-playlist command.
+func createSyncPlaylistCmd() *cobra.Command {
+	var from, title, privacy string
+	var apply bool
+
+	cmd := &cobra.Command{
+		Use:   "sync-playlist [playlist-id] --from <file>",
+		Short: "Make a playlist match a list of videos kept in a file",
+		Long: "Compare a playlist with a list of video IDs or URLs read from a YAML, JSON, CSV or text file\n" +
+			"and show the videos to add, remove and move. Changes are only made with --apply.\n" +
+			"If the playlist does not exist (or no ID is given), it is created using the title from\n" +
+			"the file or --title.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			playlistID := ""
+			if len(args) == 1 {
+				playlistID = args[0]
+			}
+			if privacy != "" && !slices.Contains(privacyStatuses, privacy) {
+				return fmt.Errorf("invalid --privacy %q (valid: %s)", privacy, strings.Join(privacyStatuses, ", "))
+			}
+			return runSyncPlaylist(cmd.Context(), playlistID, from, title, privacy, apply)
+		},
+	}
+
+	cmd.Flags().StringVar(&from, "from", "", "File listing the videos (.yaml, .json, .csv, or text; - for stdin)")
+	cmd.Flags().StringVar(&title, "title", "", "Title used if the playlist has to be created")
+	cmd.Flags().StringVar(&privacy, "privacy", "", "Privacy status used if the playlist has to be created (default private)")
+	cmd.Flags().BoolVar(&apply, "apply", false, "Apply the changes instead of only showing them")
+	cmd.MarkFlagRequired("from")
+	return cmd
+}
+
+func runSyncPlaylist(ctx context.Context, playlistID, from, title, privacy string, apply bool) error {
+	spec, err := export.ReadSpecFile(from)
+	if err != nil {
+		return err
+	}
+
+	desired, err := parseVideoIDs(spec.Videos)
+	if err != nil {
+		return err
+	}
+
+	scopes := auth.Public
+	if apply {
+		scopes = auth.Manage
+	}
+
+	authClient, err := newAuthClient(scopes)
+	if err != nil {
+		return err
+	}
+
+	service, err := authClient.GetYouTubeService(ctx)
+	if err != nil {
+		return err
+	}
+
+	playlistSvc := youtube.NewPlaylistService(service)
+
+	var current []*ytapi.PlaylistItem
+	create := playlistID == ""
+	if !create {
+		fmt.Fprintf(os.Stderr, "🔍 Fetching videos from playlist: %s...\n\n", playlistID)
+		_, err := playlistSvc.Get(ctx, playlistID)
+		switch {
+		case errors.Is(err, youtube.ErrPlaylistNotFound):
+			create = true
+		case err != nil:
+			return err
+		default:
+			current, err = playlistSvc.GetItems(ctx, playlistID, 0)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if title == "" {
+		title = spec.Title
+	}
+	if privacy == "" {
+		privacy = export.FirstNonEmpty(spec.Privacy, "private")
+	}
+	if create && title == "" {
+		return fmt.Errorf("playlist does not exist: set a title in the file or with --title to create it")
+	}
+
+	if create {
+		fmt.Printf("* create playlist %q (%s)\n", title, privacy)
+	}
+
+	desired, titles, missing, err := availableVideos(ctx, youtube.NewVideoService(service), current, desired)
+	if err != nil {
+		return err
+	}
+	for _, videoID := range missing {
+		fmt.Printf("! skip   %s: video not found\n", videoID)
+	}
+
+	plan := youtube.PlanSync(current, desired)
+	printSyncPlan(plan, titles)
+
+	if !apply {
+		if create || !plan.Empty() {
+			fmt.Fprintf(os.Stderr, "\nℹ️  Nothing was changed. Run again with --apply to apply this plan.\n")
+		}
+		return nil
+	}
+
+	if create {
+		playlist, err := playlistSvc.Create(ctx, title, spec.Description, privacy)
+		if err != nil {
+			return err
+		}
+		playlistID = playlist.Id
+		fmt.Fprintf(os.Stderr, "\n📝 Created playlist %s\n", playlistID)
+	}
+
+	if err := applySyncPlan(ctx, playlistSvc, playlistID, plan); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "\n✅ Playlist %s is in sync\n", playlistID)
+	return nil
+}
+
+// parseVideoIDs normalises video IDs and URLs, reporting every invalid value.
+func parseVideoIDs(values []string) ([]string, error) {
+	ids := make([]string, 0, len(values))
+	var invalid []string
+	for _, value := range values {
+		id, err := youtube.ParseVideoID(value)
+		if err != nil {
+			invalid = append(invalid, value)
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid video ID or URL: %s", strings.Join(invalid, ", "))
+	}
+	return ids, nil
+}
+
+// availableVideos looks up the desired videos that are not in the playlist
+// yet. Those that cannot be found, because they were deleted, made private or
+// mistyped, are dropped from desired and returned in missing, so they are left
+// out of the plan instead of failing while it is applied. titles holds the
+// titles of the videos that were found.
+func availableVideos(ctx context.Context, videoSvc *youtube.VideoService, current []*ytapi.PlaylistItem, desired []string) (available []string, titles map[string]string, missing []string, err error) {
+	present := make(map[string]bool, len(current))
+	for _, item := range current {
+		present[item.ContentDetails.VideoId] = true
+	}
+
+	var lookup []string
+	for _, videoID := range desired {
+		if !present[videoID] {
+			lookup = append(lookup, videoID)
+		}
+	}
+
+	videos, err := videoSvc.GetDetails(ctx, lookup)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	titles = make(map[string]string, len(videos))
+	for id, video := range videos {
+		titles[id] = video.Snippet.Title
+	}
+
+	available = make([]string, 0, len(desired))
+	for _, videoID := range desired {
+		if !present[videoID] && videos[videoID] == nil {
+			if !slices.Contains(missing, videoID) {
+				missing = append(missing, videoID)
+			}
+			continue
+		}
+		available = append(available, videoID)
+	}
+
+	return available, titles, missing, nil
+}
+
+// printSyncPlan prints the changes of a plan, one per line.
+func printSyncPlan(plan youtube.SyncPlan, titles map[string]string) {
+	if plan.Empty() {
+		fmt.Println("No changes: the playlist already matches.")
+		return
+	}
+
+	for _, item := range plan.Removals {
+		fmt.Printf("- remove %s (%s) from position %d\n", item.Snippet.Title, item.ContentDetails.VideoId, item.Snippet.Position+1)
+	}
+	for _, move := range plan.Moves {
+		fmt.Printf("~ move   %s (%s) to position %d\n", move.Item.Snippet.Title, move.Item.ContentDetails.VideoId, move.Position+1)
+	}
+	for _, insertion := range plan.Insertions {
+		title := titles[insertion.VideoID]
+		fmt.Printf("+ add    %s (%s) at position %d\n", title, insertion.VideoID, insertion.Position+1)
+	}
+
+	fmt.Printf("\n%d to add, %d to remove, %d to move\n", len(plan.Insertions), len(plan.Removals), len(plan.Moves))
+}
+
+// applySyncPlan applies removals, moves and insertions, in that order.
+func applySyncPlan(ctx context.Context, playlistSvc *youtube.PlaylistService, playlistID string, plan youtube.SyncPlan) error {
+	total := len(plan.Removals) + len(plan.Moves) + len(plan.Insertions)
+	step := 0
+
+	for _, item := range plan.Removals {
+		step++
+		if err := playlistSvc.RemoveVideo(ctx, item.Id); err != nil {
+			return fmt.Errorf("after %d of %d change(s): %w", step-1, total, err)
+		}
+		fmt.Printf("[%d/%d] removed %s\n", step, total, item.Snippet.Title)
+	}
+
+	for _, move := range plan.Moves {
+		step++
+		if err := playlistSvc.MoveItem(ctx, move.Item, move.Position); err != nil {
+			return fmt.Errorf("after %d of %d change(s): %w", step-1, total, err)
+		}
+		fmt.Printf("[%d/%d] moved %s to position %d\n", step, total, move.Item.Snippet.Title, move.Position+1)
+	}
+
+	for _, insertion := range plan.Insertions {
+		step++
+		if _, err := playlistSvc.InsertVideo(ctx, playlistID, insertion.VideoID, insertion.Position); err != nil {
+			return fmt.Errorf("after %d of %d change(s): %w", step-1, total, err)
+		}
+		fmt.Printf("[%d/%d] added %s at position %d\n", step, total, insertion.VideoID, insertion.Position+1)
+	}
+
+	return nil
+}
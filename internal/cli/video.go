@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -20,22 +22,74 @@ func registerVideoCommands() {
 // createSearchCmd creates the search command.
 func createSearchCmd() *cobra.Command {
 	var limit int
+	var after, before string
+	var opts youtube.SearchOptions
 
 	cmd := &cobra.Command{
-		Use:   "search <query>",
-		Short: "Search for videos on YouTube",
-		Args:  cobra.ExactArgs(1),
+		Use:   "search [query]",
+		Short: "Search for videos, channels or playlists on YouTube",
+		Long: "Search YouTube. The query may be omitted when --channel is set.\n" +
+			"Dates are YYYY-MM-DD or RFC 3339 timestamps. --duration, --definition, --caption and\n" +
+			"--event-type only apply to video searches.",
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runSearch(cmd.Context(), args[0], limit)
+			query := ""
+			if len(args) == 1 {
+				query = args[0]
+			}
+			if query == "" && opts.ChannelID == "" {
+				return fmt.Errorf("specify a query or --channel")
+			}
+
+			var err error
+			if opts.PublishedAfter, err = parseDate("published-after", after); err != nil {
+				return err
+			}
+			if opts.PublishedBefore, err = parseDate("published-before", before); err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return runSearch(cmd.Context(), query, opts, limit)
 		},
 	}
 
-	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of results")
+	flags := cmd.Flags()
+	flags.IntVar(&limit, "limit", 10, "Maximum number of results (0 for all)")
+	flags.StringVar(&opts.Type, "type", "video", "Kind of result ("+strings.Join(youtube.SearchTypes, ", ")+")")
+	flags.StringVar(&opts.ChannelID, "channel", "", "Only return results from this channel ID")
+	flags.StringVar(&after, "published-after", "", "Only return results published on or after this date")
+	flags.StringVar(&before, "published-before", "", "Only return results published before this date")
+	flags.StringVar(&opts.Order, "order", "", "Sort order ("+strings.Join(youtube.SearchOrders, ", ")+"; default relevance)")
+	flags.StringVar(&opts.RegionCode, "region", "", "Return results viewable in this country (two-letter code)")
+	flags.StringVar(&opts.RelevanceLanguage, "language", "", "Prefer results in this language (e.g. en, fr, zh-Hans)")
+	flags.StringVar(&opts.SafeSearch, "safe-search", "", "Restricted content filtering ("+strings.Join(youtube.SearchSafeSearch, ", ")+")")
+	flags.StringVar(&opts.Duration, "duration", "", "Video duration ("+strings.Join(youtube.SearchDurations, ", ")+")")
+	flags.StringVar(&opts.Definition, "definition", "", "Video definition ("+strings.Join(youtube.SearchDefinitions, ", ")+")")
+	flags.StringVar(&opts.Caption, "caption", "", "Caption availability ("+strings.Join(youtube.SearchCaptions, ", ")+")")
+	flags.StringVar(&opts.EventType, "event-type", "", "Live broadcast state ("+strings.Join(youtube.SearchEventTypes, ", ")+")")
 	return cmd
 }
 
-func runSearch(ctx context.Context, query string, limit int) error {
-	authClient, err := auth.NewClient()
+// parseDate parses a --flag date given as YYYY-MM-DD or RFC 3339. An empty
+// value gives the zero time.
+func parseDate(flag, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --%s %q: expected YYYY-MM-DD or an RFC 3339 timestamp", flag, value)
+	}
+	return t, nil
+}
+
+func runSearch(ctx context.Context, query string, opts youtube.SearchOptions, limit int) error {
+	authClient, err := newAuthClient(auth.Public)
 	if err != nil {
 		return err
 	}
@@ -45,10 +99,14 @@ func runSearch(ctx context.Context, query string, limit int) error {
 		return err
 	}
 
-	fmt.Fprintf(os.Stderr, "🔍 Searching for: \"%s\"...\n\n", query)
+	if query != "" {
+		fmt.Fprintf(os.Stderr, "🔍 Searching for: \"%s\"...\n\n", query)
+	} else {
+		fmt.Fprintf(os.Stderr, "🔍 Searching channel: %s...\n\n", opts.ChannelID)
+	}
 
 	videoSvc := youtube.NewVideoService(service)
-	results, err := videoSvc.Search(ctx, query, limit)
+	results, err := videoSvc.Search(ctx, query, opts, limit)
 	if err != nil {
 		return err
 	}
@@ -59,18 +117,33 @@ func runSearch(ctx context.Context, query string, limit int) error {
 
 // createGetVideoCmd creates the get-video command.
 func createGetVideoCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:   "get-video <video-id>",
-		Short: "Get detailed information about a video",
-		Args:  cobra.ExactArgs(1),
+	var file string
+
+	cmd := &cobra.Command{
+		Use:   "get-video [video-id|url]...",
+		Short: "Get detailed information about videos",
+		Long: "Get detailed information about videos, given as IDs or URLs. Videos are read from the\n" +
+			"arguments, from --file, or one per line from stdin when neither is given. Up to 50 videos\n" +
+			"are fetched per API call.",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runGetVideo(cmd.Context(), args[0])
+			values, err := videoArgs(args, file)
+			if err != nil {
+				return err
+			}
+			videoIDs, err := parseVideoIDs(values)
+			if err != nil {
+				return err
+			}
+			return runGetVideo(cmd.Context(), videoIDs)
 		},
 	}
+
+	cmd.Flags().StringVar(&file, "file", "", "File listing the videos (.yaml, .json, .csv, or text; - for stdin)")
+	return cmd
 }
 
-func runGetVideo(ctx context.Context, videoID string) error {
-	authClient, err := auth.NewClient()
+func runGetVideo(ctx context.Context, videoIDs []string) error {
+	authClient, err := newAuthClient(auth.Public)
 	if err != nil {
 		return err
 	}
@@ -80,14 +153,27 @@ func runGetVideo(ctx context.Context, videoID string) error {
 		return err
 	}
 
-	fmt.Fprintf(os.Stderr, "📹 Fetching video info: %s...\n\n", videoID)
+	if len(videoIDs) == 1 {
+		fmt.Fprintf(os.Stderr, "📹 Fetching video info: %s...\n\n", videoIDs[0])
+	} else {
+		fmt.Fprintf(os.Stderr, "📹 Fetching info for %d videos...\n\n", len(videoIDs))
+	}
 
 	videoSvc := youtube.NewVideoService(service)
-	video, err := videoSvc.Get(ctx, videoID)
+	videos, missing, err := videoSvc.GetMany(ctx, videoIDs)
 	if err != nil {
 		return err
 	}
 
-	youtube.PrintVideo(video)
+	for i, video := range videos {
+		if i > 0 {
+			fmt.Println()
+		}
+		youtube.PrintVideo(video)
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("video not found: %s", strings.Join(missing, ", "))
+	}
 	return nil
 }
@@ -0,0 +1,114 @@
+package export
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// backupVersion is the archive schema version, bumped on incompatible changes.
+const backupVersion = 1
+
+// Backup is a snapshot of playlists and their items at a point in time.
+type Backup struct {
+	Version   int              `json:"version"`
+	CreatedAt time.Time        `json:"created_at"`
+	Playlists []PlaylistBackup `json:"playlists"`
+}
+
+// PlaylistBackup is one playlist in a backup. Its entries are in playlist
+// order.
+type PlaylistBackup struct {
+	ID          string  `json:"id"`
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	Privacy     string  `json:"privacy"`
+	Entries     []Entry `json:"entries"`
+}
+
+// NewBackup creates an empty backup taken now.
+func NewBackup() *Backup {
+	return &Backup{Version: backupVersion, CreatedAt: time.Now().UTC()}
+}
+
+// Find returns the playlist with the given ID, or nil if it is not in the
+// backup.
+func (b *Backup) Find(playlistID string) *PlaylistBackup {
+	for i := range b.Playlists {
+		if b.Playlists[i].ID == playlistID {
+			return &b.Playlists[i]
+		}
+	}
+	return nil
+}
+
+// BackupFileName returns the archive file name for a backup taken at t.
+func BackupFileName(t time.Time) string {
+	return "youtube-backup-" + t.UTC().Format("20060102T150405Z") + ".json.gz"
+}
+
+// WriteBackupFile writes a gzip-compressed JSON archive to path. The file is
+// written under a temporary name first, so an interrupted backup never leaves
+// a truncated archive behind.
+func WriteBackupFile(path string, backup *Backup) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".youtube-backup-*")
+	if err != nil {
+		return fmt.Errorf("unable to create backup file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	zw := gzip.NewWriter(tmp)
+	encoder := json.NewEncoder(zw)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(backup); err != nil {
+		tmp.Close()
+		return fmt.Errorf("unable to write backup: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("unable to write backup: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("unable to write backup: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("unable to save backup: %w", err)
+	}
+	return nil
+}
+
+// ReadBackupFile reads an archive written by WriteBackupFile. Files not ending
+// in .gz are read as plain JSON.
+func ReadBackupFile(path string) (*Backup, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open backup: %w", err)
+	}
+	defer file.Close()
+
+	var r io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		zr, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read backup %s: %w", path, err)
+		}
+		defer zr.Close()
+		r = zr
+	}
+
+	var backup Backup
+	if err := json.NewDecoder(r).Decode(&backup); err != nil {
+		return nil, fmt.Errorf("unable to read backup %s: %w", path, err)
+	}
+	if backup.Version > backupVersion {
+		return nil, fmt.Errorf("backup %s was written by a newer version (schema %d)", path, backup.Version)
+	}
+
+	return &backup, nil
+}
@@ -0,0 +1,196 @@
+// Package export reads and writes playlist files: entries exported to JSON,
+// CSV, M3U and YAML, video lists used to sync playlists, and backup archives.
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Formats lists the supported export formats.
+var Formats = []string{"json", "csv", "m3u", "yaml"}
+
+// Entry is one exported playlist item. Field names form a stable schema
+// shared by every format.
+type Entry struct {
+	Position        int    `json:"position" yaml:"position"`
+	VideoID         string `json:"video_id" yaml:"video_id"`
+	Title           string `json:"title" yaml:"title"`
+	Channel         string `json:"channel" yaml:"channel"`
+	AddedAt         string `json:"added_at" yaml:"added_at"`
+	DurationSeconds int64  `json:"duration_seconds" yaml:"duration_seconds"`
+	Note            string `json:"note" yaml:"note"`
+}
+
+// URL returns the watch URL of the entry's video.
+func (e Entry) URL() string {
+	return "https://www.youtube.com/watch?v=" + e.VideoID
+}
+
+// Writer writes entries one at a time, so large playlists never have to be
+// held in memory.
+type Writer interface {
+	// Write appends an entry.
+	Write(entry Entry) error
+	// Close finishes the document. It does not close the underlying writer.
+	Close() error
+}
+
+// NewWriter creates a writer for format.
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case "json":
+		return &jsonWriter{w: w}, nil
+	case "csv":
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case "m3u":
+		return &m3uWriter{w: w}, nil
+	case "yaml":
+		return &yamlWriter{w: w}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format %q (valid: %s)", format, strings.Join(Formats, ", "))
+	}
+}
+
+// jsonWriter writes a JSON array, one entry per line.
+type jsonWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (jw *jsonWriter) Write(entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	prefix := ",\n  "
+	if jw.count == 0 {
+		prefix = "[\n  "
+	}
+	jw.count++
+
+	_, err = fmt.Fprintf(jw.w, "%s%s", prefix, data)
+	return err
+}
+
+func (jw *jsonWriter) Close() error {
+	if jw.count == 0 {
+		_, err := io.WriteString(jw.w, "[]\n")
+		return err
+	}
+	_, err := io.WriteString(jw.w, "\n]\n")
+	return err
+}
+
+// csvWriter writes a header row followed by one row per entry.
+type csvWriter struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func (cw *csvWriter) writeHeader() error {
+	if cw.wroteHeader {
+		return nil
+	}
+	cw.wroteHeader = true
+	return cw.w.Write([]string{"position", "video_id", "title", "channel", "added_at", "duration_seconds", "note"})
+}
+
+func (cw *csvWriter) Write(entry Entry) error {
+	if err := cw.writeHeader(); err != nil {
+		return err
+	}
+	return cw.w.Write([]string{
+		strconv.Itoa(entry.Position),
+		entry.VideoID,
+		entry.Title,
+		entry.Channel,
+		entry.AddedAt,
+		strconv.FormatInt(entry.DurationSeconds, 10),
+		entry.Note,
+	})
+}
+
+func (cw *csvWriter) Close() error {
+	if err := cw.writeHeader(); err != nil {
+		return err
+	}
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// m3uWriter writes an extended M3U playlist of watch URLs.
+type m3uWriter struct {
+	w           io.Writer
+	wroteHeader bool
+}
+
+func (mw *m3uWriter) writeHeader() error {
+	if mw.wroteHeader {
+		return nil
+	}
+	mw.wroteHeader = true
+	_, err := io.WriteString(mw.w, "#EXTM3U\n")
+	return err
+}
+
+func (mw *m3uWriter) Write(entry Entry) error {
+	if err := mw.writeHeader(); err != nil {
+		return err
+	}
+
+	// -1 is the conventional duration for unknown lengths.
+	duration := entry.DurationSeconds
+	if duration == 0 {
+		duration = -1
+	}
+
+	title := entry.Title
+	if entry.Channel != "" {
+		title = entry.Channel + " - " + entry.Title
+	}
+	// Line breaks would end the directive early.
+	title = strings.NewReplacer("\r", " ", "\n", " ").Replace(title)
+
+	_, err := fmt.Fprintf(mw.w, "#EXTINF:%d,%s\n%s\n", duration, title, entry.URL())
+	return err
+}
+
+func (mw *m3uWriter) Close() error {
+	return mw.writeHeader()
+}
+
+// yamlWriter writes a YAML sequence, one entry at a time.
+type yamlWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (yw *yamlWriter) Write(entry Entry) error {
+	yw.count++
+
+	// Marshalling a one-element list yields a sequence item that can be
+	// appended to the previous ones.
+	data, err := yaml.Marshal([]Entry{entry})
+	if err != nil {
+		return err
+	}
+
+	_, err = yw.w.Write(data)
+	return err
+}
+
+func (yw *yamlWriter) Close() error {
+	if yw.count == 0 {
+		_, err := io.WriteString(yw.w, "[]\n")
+		return err
+	}
+	return nil
+}
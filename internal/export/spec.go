@@ -0,0 +1,230 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Spec is a playlist described in a file: its videos, in order, and
+// optionally the metadata used when the playlist has to be created.
+type Spec struct {
+	Title       string
+	Description string
+	Privacy     string
+	// Videos holds video IDs or URLs as written in the file.
+	Videos []string
+}
+
+// specDocument is the structured form of a JSON or YAML spec file. Videos may
+// be plain strings or exported entries.
+type specDocument struct {
+	Title       string      `json:"title" yaml:"title"`
+	Description string      `json:"description" yaml:"description"`
+	Privacy     string      `json:"privacy" yaml:"privacy"`
+	Videos      []specVideo `json:"videos" yaml:"videos"`
+}
+
+// specVideo accepts either a bare string or an object with a video_id (or
+// url) field, so files written by export-playlist can be read back.
+type specVideo string
+
+func (v *specVideo) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*v = specVideo(s)
+		return nil
+	}
+
+	var entry struct {
+		VideoID string `json:"video_id"`
+		URL     string `json:"url"`
+	}
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return fmt.Errorf("video must be a string or an object with video_id: %w", err)
+	}
+	*v = specVideo(FirstNonEmpty(entry.VideoID, entry.URL))
+	return nil
+}
+
+func (v *specVideo) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*v = specVideo(node.Value)
+		return nil
+	}
+
+	var entry struct {
+		VideoID string `yaml:"video_id"`
+		URL     string `yaml:"url"`
+	}
+	if err := node.Decode(&entry); err != nil {
+		return fmt.Errorf("video must be a string or a mapping with video_id: %w", err)
+	}
+	*v = specVideo(FirstNonEmpty(entry.VideoID, entry.URL))
+	return nil
+}
+
+// ReadSpecFile reads a playlist spec, choosing the format from the file
+// extension: .yaml/.yml, .json, .csv, or plain text (one video per line,
+// # starts a comment) for anything else. A path of - reads plain text from
+// stdin.
+func ReadSpecFile(path string) (*Spec, error) {
+	if path == "-" {
+		return ReadSpec(os.Stdin, "text")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	format := "text"
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		format = "yaml"
+	case ".json":
+		format = "json"
+	case ".csv":
+		format = "csv"
+	}
+
+	spec, err := ReadSpec(file, format)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s: %w", path, err)
+	}
+	return spec, nil
+}
+
+// ReadSpec reads a playlist spec in format: yaml, json, csv or text. JSON and
+// YAML files hold either a list of videos or a document with title,
+// description, privacy and videos keys.
+func ReadSpec(r io.Reader, format string) (*Spec, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec *Spec
+	switch format {
+	case "json":
+		spec, err = readStructured(data, json.Unmarshal)
+	case "yaml":
+		spec, err = readStructured(data, yaml.Unmarshal)
+	case "csv":
+		spec, err = readCSV(data)
+	case "text":
+		spec = readText(data)
+	default:
+		err = fmt.Errorf("unsupported spec format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	videos := spec.Videos[:0]
+	for _, video := range spec.Videos {
+		if video = strings.TrimSpace(video); video != "" {
+			videos = append(videos, video)
+		}
+	}
+	spec.Videos = videos
+	return spec, nil
+}
+
+// readStructured decodes a JSON or YAML list or document.
+func readStructured(data []byte, unmarshal func([]byte, any) error) (*Spec, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return &Spec{}, nil
+	}
+
+	var list []specVideo
+	if err := unmarshal(trimmed, &list); err == nil {
+		return &Spec{Videos: toStrings(list)}, nil
+	}
+
+	var doc specDocument
+	if err := unmarshal(trimmed, &doc); err != nil {
+		return nil, err
+	}
+
+	return &Spec{
+		Title:       doc.Title,
+		Description: doc.Description,
+		Privacy:     doc.Privacy,
+		Videos:      toStrings(doc.Videos),
+	}, nil
+}
+
+// readCSV reads the video_id (or url) column, or the first column when the
+// file has no recognised header.
+func readCSV(data []byte) (*Spec, error) {
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return &Spec{}, nil
+	}
+
+	column := -1
+	for i, name := range records[0] {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "video_id" || (name == "url" && column == -1) {
+			column = i
+		}
+	}
+	if column == -1 {
+		column = 0
+	} else {
+		records = records[1:]
+	}
+
+	spec := &Spec{}
+	for _, record := range records {
+		if column >= len(record) {
+			return nil, errors.New("row is missing the video column")
+		}
+		spec.Videos = append(spec.Videos, record[column])
+	}
+	return spec, nil
+}
+
+// readText reads one video per line, skipping blank lines and # comments.
+func readText(data []byte) *Spec {
+	spec := &Spec{}
+	for _, line := range strings.Split(string(data), "\n") {
+		if i := strings.Index(line, "#"); i == 0 || (i > 0 && line[i-1] == ' ') {
+			line = line[:i]
+		}
+		spec.Videos = append(spec.Videos, line)
+	}
+	return spec
+}
+
+func toStrings(videos []specVideo) []string {
+	result := make([]string, len(videos))
+	for i, video := range videos {
+		result[i] = string(video)
+	}
+	return result
+}
+
+// FirstNonEmpty returns the first non-empty value.
+func FirstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
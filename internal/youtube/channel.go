@@ -0,0 +1,33 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/api/youtube/v3"
+)
+
+// ChannelService handles channel operations.
+type ChannelService struct {
+	service *youtube.Service
+}
+
+// NewChannelService creates a new channel service.
+func NewChannelService(service *youtube.Service) *ChannelService {
+	return &ChannelService{service: service}
+}
+
+// Mine retrieves the channel owned by the authenticated user.
+func (cs *ChannelService) Mine(ctx context.Context) (*youtube.Channel, error) {
+	call := cs.service.Channels.List([]string{"snippet"}).Mine(true)
+	response, err := call.Do()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching channel: %w", err)
+	}
+
+	if len(response.Items) == 0 {
+		return nil, fmt.Errorf("no channel found for this account")
+	}
+
+	return response.Items[0], nil
+}
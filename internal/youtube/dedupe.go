@@ -0,0 +1,55 @@
+package youtube
+
+import (
+	"google.golang.org/api/youtube/v3"
+)
+
+// KeepModes lists which occurrence of a duplicated video FindDuplicates keeps.
+var KeepModes = []string{"first", "last"}
+
+// Duplicate is a video found more than once: Kept stays, Removed are the
+// other occurrences.
+type Duplicate struct {
+	Kept    *youtube.PlaylistItem
+	Removed []*youtube.PlaylistItem
+}
+
+// FindDuplicates groups items sharing a video ID, keeping the first or last
+// occurrence depending on keep. Items may come from several playlists, in
+// which case their order decides which occurrence is first. Groups are
+// returned in order of the first occurrence. An item listed twice counts
+// once, so it is never both kept and removed.
+func FindDuplicates(items []*youtube.PlaylistItem, keep string) []Duplicate {
+	groups := make(map[string][]*youtube.PlaylistItem)
+	seen := make(map[string]bool, len(items))
+	var order []string
+	for _, item := range items {
+		if seen[item.Id] {
+			continue
+		}
+		seen[item.Id] = true
+
+		id := item.ContentDetails.VideoId
+		if _, ok := groups[id]; !ok {
+			order = append(order, id)
+		}
+		groups[id] = append(groups[id], item)
+	}
+
+	var duplicates []Duplicate
+	for _, id := range order {
+		group := groups[id]
+		if len(group) < 2 {
+			continue
+		}
+
+		if keep == "last" {
+			last := len(group) - 1
+			duplicates = append(duplicates, Duplicate{Kept: group[last], Removed: group[:last]})
+		} else {
+			duplicates = append(duplicates, Duplicate{Kept: group[0], Removed: group[1:]})
+		}
+	}
+
+	return duplicates
+}
@@ -0,0 +1,53 @@
+package youtube
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// isoDurationPattern matches the ISO-8601 durations returned in
+// contentDetails.duration, such as PT1H2M3S or P1DT2H.
+var isoDurationPattern = regexp.MustCompile(`^P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// ParseDuration parses an ISO-8601 video duration.
+func ParseDuration(value string) (time.Duration, error) {
+	match := isoDurationPattern.FindStringSubmatch(value)
+	if match == nil || value == "P" || value == "PT" {
+		return 0, fmt.Errorf("invalid ISO-8601 duration: %q", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute}
+	var total time.Duration
+	for i, unit := range units {
+		if match[i+1] == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(match[i+1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO-8601 duration: %q", value)
+		}
+		total += time.Duration(n) * unit
+	}
+
+	if match[5] != "" {
+		seconds, err := strconv.ParseFloat(match[5], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO-8601 duration: %q", value)
+		}
+		total += time.Duration(seconds * float64(time.Second))
+	}
+
+	return total, nil
+}
+
+// FormatDuration formats a duration as H:MM:SS, or M:SS under an hour.
+func FormatDuration(d time.Duration) string {
+	seconds := int64(d.Round(time.Second) / time.Second)
+	h, m, s := seconds/3600, seconds/60%60, seconds%60
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("%d:%02d", m, s)
+}
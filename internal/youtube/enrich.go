@@ -0,0 +1,53 @@
+package youtube
+
+import (
+	"context"
+
+	"google.golang.org/api/youtube/v3"
+)
+
+// EachItemWithVideo streams the items of a playlist to fn together with their
+// video details, fetched in batches of 50 as pages arrive. The video is nil
+// for items whose video is deleted or not visible, up to limit items (no limit
+// if limit <= 0).
+func EachItemWithVideo(ctx context.Context, ps *PlaylistService, vs *VideoService, playlistID string, limit int,
+	fn func(*youtube.PlaylistItem, *youtube.Video) error) error {
+	batch := make([]*youtube.PlaylistItem, 0, maxPageSize)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		ids := make([]string, len(batch))
+		for i, item := range batch {
+			ids[i] = item.ContentDetails.VideoId
+		}
+
+		videos, err := vs.GetDetails(ctx, ids)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range batch {
+			if err := fn(item, videos[item.ContentDetails.VideoId]); err != nil {
+				return err
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	err := ps.EachItem(ctx, playlistID, limit, func(item *youtube.PlaylistItem) error {
+		batch = append(batch, item)
+		if len(batch) == maxPageSize {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return flush()
+}
@@ -0,0 +1,95 @@
+package youtube
+
+import (
+	"slices"
+	"strings"
+
+	"google.golang.org/api/youtube/v3"
+)
+
+// Health statuses reported by CheckItem, from least to most severe.
+const (
+	HealthOK            = "ok"
+	HealthNotEmbeddable = "not-embeddable"
+	HealthRegionBlocked = "region-blocked"
+	HealthAgeRestricted = "age-restricted"
+	HealthOwnPrivate    = "own-private"
+	HealthPrivate       = "private"
+	HealthDeleted       = "deleted"
+)
+
+const (
+	// privateVideoTitle is the placeholder title of playlist items whose
+	// video was made private.
+	privateVideoTitle   = "Private video"
+	deletedVideoTitle   = "Deleted video"
+	ageRestrictedRating = "ytAgeRestricted"
+)
+
+// HealthProblems lists the statuses CheckItem reports for broken items.
+var HealthProblems = []string{HealthDeleted, HealthPrivate, HealthOwnPrivate, HealthAgeRestricted, HealthRegionBlocked, HealthNotEmbeddable}
+
+// HealthUnavailable lists the statuses of items nobody can play: placeholders
+// left by deleted and private videos.
+var HealthUnavailable = []string{HealthDeleted, HealthPrivate}
+
+// CheckItem classifies a playlist item from its video details, which are nil
+// when videos.list did not return the video. The most severe problem wins.
+// A private video that videos.list still returns belongs to the signed-in
+// user, who can play it, and is reported as own-private rather than private.
+// region is an ISO 3166-1 alpha-2 code; if empty, region restrictions are
+// not checked.
+func CheckItem(item *youtube.PlaylistItem, video *youtube.Video, region string) string {
+	if video == nil {
+		// Deleted and private videos both disappear from videos.list; the
+		// placeholder title left on the playlist item tells them apart.
+		if item.Snippet.Title == privateVideoTitle {
+			return HealthPrivate
+		}
+		return HealthDeleted
+	}
+
+	if status := video.Status; status != nil {
+		switch status.UploadStatus {
+		case "deleted", "failed", "rejected":
+			return HealthDeleted
+		}
+		if status.PrivacyStatus == "private" {
+			return HealthOwnPrivate
+		}
+	}
+
+	if details := video.ContentDetails; details != nil {
+		if details.ContentRating != nil && details.ContentRating.YtRating == ageRestrictedRating {
+			return HealthAgeRestricted
+		}
+		if regionBlocked(details.RegionRestriction, region) {
+			return HealthRegionBlocked
+		}
+	}
+
+	if video.Status != nil && !video.Status.Embeddable {
+		return HealthNotEmbeddable
+	}
+
+	return HealthOK
+}
+
+// IsUnavailable reports whether an item is a placeholder left by a deleted or
+// private video, which cannot be added to another playlist.
+func IsUnavailable(item *youtube.PlaylistItem) bool {
+	return item.Snippet.Title == privateVideoTitle || item.Snippet.Title == deletedVideoTitle
+}
+
+// regionBlocked reports whether a restriction hides the video in region.
+func regionBlocked(restriction *youtube.VideoContentDetailsRegionRestriction, region string) bool {
+	if restriction == nil || region == "" {
+		return false
+	}
+
+	region = strings.ToUpper(region)
+	if slices.Contains(restriction.Blocked, region) {
+		return true
+	}
+	return len(restriction.Allowed) > 0 && !slices.Contains(restriction.Allowed, region)
+}
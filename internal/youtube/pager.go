@@ -0,0 +1,54 @@
+package youtube
+
+// maxPageSize is the largest page size the YouTube Data API accepts.
+const maxPageSize = 50
+
+// pageFetcher fetches one page of results of at most pageSize items starting
+// at pageToken, and returns the token of the next page ("" on the last one).
+type pageFetcher[T any] func(pageToken string, pageSize int64) (items []T, nextPageToken string, err error)
+
+// paginate fetches page after page, following NextPageToken, and passes each
+// item to yield until limit items were produced or no pages are left. Page
+// sizes are clamped to what the API accepts. A limit of zero or less means no
+// limit. Iteration stops at the first error returned by fetch or yield.
+func paginate[T any](limit int, fetch pageFetcher[T], yield func(T) error) error {
+	produced := 0
+	pageToken := ""
+
+	for {
+		pageSize := maxPageSize
+		if limit > 0 && limit-produced < pageSize {
+			pageSize = limit - produced
+		}
+
+		items, nextPageToken, err := fetch(pageToken, int64(pageSize))
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			if err := yield(item); err != nil {
+				return err
+			}
+			produced++
+			if limit > 0 && produced >= limit {
+				return nil
+			}
+		}
+
+		if nextPageToken == "" || len(items) == 0 {
+			return nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+// collectPages gathers up to limit items from paginate into a slice.
+func collectPages[T any](limit int, fetch pageFetcher[T]) ([]T, error) {
+	var all []T
+	err := paginate(limit, fetch, func(item T) error {
+		all = append(all, item)
+		return nil
+	})
+	return all, err
+}
@@ -3,12 +3,17 @@ package youtube
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 
 	"google.golang.org/api/youtube/v3"
 )
 
+// ErrPlaylistNotFound is returned when a playlist does not exist or is not
+// visible to the caller.
+var ErrPlaylistNotFound = errors.New("playlist not found")
+
 // PlaylistService handles playlist operations.
 type PlaylistService struct {
 	service *youtube.Service
@@ -19,29 +24,45 @@ func NewPlaylistService(service *youtube.Service) *PlaylistService {
 	return &PlaylistService{service: service}
 }
 
-// List retrieves user's playlists.
+// List retrieves user's playlists, up to limit (no limit if limit <= 0).
 func (ps *PlaylistService) List(ctx context.Context, limit int) ([]*youtube.Playlist, error) {
-	call := ps.service.Playlists.List([]string{"snippet", "contentDetails"}).
-		Mine(true).
-		MaxResults(int64(limit))
+	return collectPages(limit, func(pageToken string, pageSize int64) ([]*youtube.Playlist, string, error) {
+		call := ps.service.Playlists.List([]string{"snippet", "status", "contentDetails"}).
+			Mine(true).
+			MaxResults(pageSize).
+			Context(ctx)
 
-	response, err := call.Do()
-	if err != nil {
-		return nil, fmt.Errorf("error fetching playlists: %w", err)
-	}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		response, err := call.Do()
+		if err != nil {
+			return nil, "", fmt.Errorf("error fetching playlists: %w", err)
+		}
 
-	return response.Items, nil
+		return response.Items, response.NextPageToken, nil
+	})
 }
 
-// GetItems retrieves videos from a playlist.
+// GetItems retrieves videos from a playlist, up to limit (no limit if limit <= 0).
 func (ps *PlaylistService) GetItems(ctx context.Context, playlistID string, limit int) ([]*youtube.PlaylistItem, error) {
-	var allVideos []*youtube.PlaylistItem
-	pageToken := ""
+	var items []*youtube.PlaylistItem
+	err := ps.EachItem(ctx, playlistID, limit, func(item *youtube.PlaylistItem) error {
+		items = append(items, item)
+		return nil
+	})
+	return items, err
+}
 
-	for {
+// EachItem streams videos from a playlist to fn as pages arrive, up to limit
+// (no limit if limit <= 0). Iteration stops early if fn returns an error.
+func (ps *PlaylistService) EachItem(ctx context.Context, playlistID string, limit int, fn func(*youtube.PlaylistItem) error) error {
+	return paginate(limit, func(pageToken string, pageSize int64) ([]*youtube.PlaylistItem, string, error) {
 		call := ps.service.PlaylistItems.List([]string{"snippet", "contentDetails"}).
 			PlaylistId(playlistID).
-			MaxResults(int64(limit))
+			MaxResults(pageSize).
+			Context(ctx)
 
 		if pageToken != "" {
 			call = call.PageToken(pageToken)
@@ -49,18 +70,11 @@ func (ps *PlaylistService) GetItems(ctx context.Context, playlistID string, limi
 
 		response, err := call.Do()
 		if err != nil {
-			return nil, fmt.Errorf("error fetching playlist items: %w", err)
-		}
-
-		allVideos = append(allVideos, response.Items...)
-
-		if response.NextPageToken == "" {
-			break
+			return nil, "", fmt.Errorf("error fetching playlist items: %w", err)
 		}
-		pageToken = response.NextPageToken
-	}
 
-	return allVideos, nil
+		return response.Items, response.NextPageToken, nil
+	}, fn)
 }
 
 // Create creates a new playlist.
@@ -84,6 +98,74 @@ func (ps *PlaylistService) Create(ctx context.Context, title, description, priva
 	return response, nil
 }
 
+// Get retrieves a single playlist by ID.
+func (ps *PlaylistService) Get(ctx context.Context, playlistID string) (*youtube.Playlist, error) {
+	call := ps.service.Playlists.List([]string{"snippet", "status", "contentDetails"}).Id(playlistID)
+	response, err := call.Do()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching playlist: %w", err)
+	}
+
+	if len(response.Items) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrPlaylistNotFound, playlistID)
+	}
+
+	return response.Items[0], nil
+}
+
+// PlaylistUpdate lists the playlist fields to change. Nil fields keep their
+// current value.
+type PlaylistUpdate struct {
+	Title           *string
+	Description     *string
+	Privacy         *string
+	DefaultLanguage *string
+}
+
+// Update changes some fields of a playlist. The API replaces whole parts, so
+// the snippet and status of current, as returned by Get, are sent back with
+// only the requested fields changed.
+func (ps *PlaylistService) Update(ctx context.Context, current *youtube.Playlist, update PlaylistUpdate) (*youtube.Playlist, error) {
+	snippet := &youtube.PlaylistSnippet{
+		Title:           current.Snippet.Title,
+		Description:     current.Snippet.Description,
+		DefaultLanguage: current.Snippet.DefaultLanguage,
+		Tags:            current.Snippet.Tags,
+		// Send an emptied description explicitly instead of omitting it.
+		ForceSendFields: []string{"Description"},
+	}
+	status := &youtube.PlaylistStatus{
+		PrivacyStatus: current.Status.PrivacyStatus,
+	}
+
+	if update.Title != nil {
+		snippet.Title = *update.Title
+	}
+	if update.Description != nil {
+		snippet.Description = *update.Description
+	}
+	if update.DefaultLanguage != nil {
+		snippet.DefaultLanguage = *update.DefaultLanguage
+	}
+	if update.Privacy != nil {
+		status.PrivacyStatus = *update.Privacy
+	}
+
+	playlist := &youtube.Playlist{
+		Id:      current.Id,
+		Snippet: snippet,
+		Status:  status,
+	}
+
+	call := ps.service.Playlists.Update([]string{"snippet", "status"}, playlist)
+	response, err := call.Do()
+	if err != nil {
+		return nil, fmt.Errorf("error updating playlist: %w", err)
+	}
+
+	return response, nil
+}
+
 // Delete deletes a playlist.
 func (ps *PlaylistService) Delete(ctx context.Context, playlistID string) error {
 	call := ps.service.Playlists.Delete(playlistID)
@@ -96,6 +178,13 @@ func (ps *PlaylistService) Delete(ctx context.Context, playlistID string) error
 
 // AddVideo adds a video to a playlist.
 func (ps *PlaylistService) AddVideo(ctx context.Context, playlistID, videoID string) error {
+	_, err := ps.InsertVideo(ctx, playlistID, videoID, -1)
+	return err
+}
+
+// InsertVideo adds a video to a playlist at a 0-based position and returns the
+// new playlist item. A negative position appends the video.
+func (ps *PlaylistService) InsertVideo(ctx context.Context, playlistID, videoID string, position int64) (*youtube.PlaylistItem, error) {
 	playlistItem := &youtube.PlaylistItem{
 		Snippet: &youtube.PlaylistItemSnippet{
 			PlaylistId: playlistID,
@@ -105,15 +194,82 @@ func (ps *PlaylistService) AddVideo(ctx context.Context, playlistID, videoID str
 			},
 		},
 	}
+	if position >= 0 {
+		playlistItem.Snippet.Position = position
+		// Position 0 would otherwise be dropped as an empty value.
+		playlistItem.Snippet.ForceSendFields = []string{"Position"}
+	}
 
 	call := ps.service.PlaylistItems.Insert([]string{"snippet"}, playlistItem)
+	response, err := call.Do()
+	if err != nil {
+		return nil, fmt.Errorf("error adding video to playlist: %w", err)
+	}
+
+	return response, nil
+}
+
+// MoveItem moves a playlist item to a 0-based position within its playlist.
+func (ps *PlaylistService) MoveItem(ctx context.Context, item *youtube.PlaylistItem, position int64) error {
+	update := &youtube.PlaylistItem{
+		Id: item.Id,
+		Snippet: &youtube.PlaylistItemSnippet{
+			PlaylistId:      item.Snippet.PlaylistId,
+			ResourceId:      item.Snippet.ResourceId,
+			Position:        position,
+			ForceSendFields: []string{"Position"},
+		},
+	}
+
+	call := ps.service.PlaylistItems.Update([]string{"snippet"}, update)
 	if _, err := call.Do(); err != nil {
-		return fmt.Errorf("error adding video to playlist: %w", err)
+		return fmt.Errorf("error moving playlist item: %w", err)
 	}
 
 	return nil
 }
 
+// RemoveVideo removes an item from a playlist. The playlist-item ID is the ID
+// of the item in the playlist, not the video ID.
+func (ps *PlaylistService) RemoveVideo(ctx context.Context, playlistItemID string) error {
+	call := ps.service.PlaylistItems.Delete(playlistItemID)
+	if err := call.Do(); err != nil {
+		return fmt.Errorf("error removing video from playlist: %w", err)
+	}
+
+	return nil
+}
+
+// FindVideo returns every item of a playlist holding videoID, in playlist order.
+func (ps *PlaylistService) FindVideo(ctx context.Context, playlistID, videoID string) ([]*youtube.PlaylistItem, error) {
+	var matches []*youtube.PlaylistItem
+	err := ps.EachItem(ctx, playlistID, 0, func(item *youtube.PlaylistItem) error {
+		if item.ContentDetails.VideoId == videoID {
+			matches = append(matches, item)
+		}
+		return nil
+	})
+	return matches, err
+}
+
+// ItemAt returns the item at a 1-based position in a playlist.
+func (ps *PlaylistService) ItemAt(ctx context.Context, playlistID string, position int) (*youtube.PlaylistItem, error) {
+	if position < 1 {
+		return nil, fmt.Errorf("invalid position %d: positions start at 1", position)
+	}
+
+	items, err := ps.GetItems(ctx, playlistID, position)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) < position {
+		return nil, fmt.Errorf("position %d is out of range: playlist has %d video(s)", position, len(items))
+	}
+
+	return items[position-1], nil
+}
+
 // PrintPlaylists prints playlists to stdout.
 func PrintPlaylists(playlists []*youtube.Playlist) {
 	if len(playlists) == 0 {
@@ -139,9 +295,14 @@ func PrintPlaylistItems(items []*youtube.PlaylistItem) {
 
 	fmt.Fprintf(os.Stderr, "✅ Found %d video(s):\n\n", len(items))
 	for idx, video := range items {
-		fmt.Printf("%d. %s\n", idx+1, video.Snippet.Title)
-		fmt.Printf("   Video ID: %s\n", video.ContentDetails.VideoId)
-		fmt.Printf("   Channel: %s\n", video.Snippet.ChannelTitle)
-		fmt.Printf("   Link: https://www.youtube.com/watch?v=%s\n\n", video.ContentDetails.VideoId)
+		PrintPlaylistItem(idx+1, video)
 	}
 }
+
+// PrintPlaylistItem prints a single playlist item, numbered n, to stdout.
+func PrintPlaylistItem(n int, video *youtube.PlaylistItem) {
+	fmt.Printf("%d. %s\n", n, video.Snippet.Title)
+	fmt.Printf("   Video ID: %s\n", video.ContentDetails.VideoId)
+	fmt.Printf("   Channel: %s\n", video.Snippet.ChannelTitle)
+	fmt.Printf("   Link: https://www.youtube.com/watch?v=%s\n\n", video.ContentDetails.VideoId)
+}
@@ -0,0 +1,193 @@
+package youtube
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/api/youtube/v3"
+)
+
+// SortFields lists the fields a playlist can be sorted by.
+var SortFields = []string{"title", "published", "duration", "views", "channel"}
+
+// Move repositions a playlist item to a 0-based position.
+type Move struct {
+	Item     *youtube.PlaylistItem
+	Position int64
+}
+
+// SortItems returns the items ordered by field, using videos (keyed by video
+// ID) for durations, view counts and channels. Items without video details,
+// such as deleted videos, always sort last. The sort is stable.
+func SortItems(items []*youtube.PlaylistItem, videos map[string]*youtube.Video, field string, desc bool) ([]*youtube.PlaylistItem, error) {
+	less, err := sortLess(videos, field)
+	if err != nil {
+		return nil, err
+	}
+
+	sorted := append([]*youtube.PlaylistItem(nil), items...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		_, aKnown := videos[a.ContentDetails.VideoId]
+		_, bKnown := videos[b.ContentDetails.VideoId]
+		if aKnown != bKnown {
+			return aKnown
+		}
+		if desc {
+			return less(b, a)
+		}
+		return less(a, b)
+	})
+
+	return sorted, nil
+}
+
+// sortLess returns the ordering function for a sort field.
+func sortLess(videos map[string]*youtube.Video, field string) (func(a, b *youtube.PlaylistItem) bool, error) {
+	switch field {
+	case "title":
+		return func(a, b *youtube.PlaylistItem) bool {
+			return strings.ToLower(a.Snippet.Title) < strings.ToLower(b.Snippet.Title)
+		}, nil
+	case "published":
+		return func(a, b *youtube.PlaylistItem) bool {
+			return videoPublishedAt(a, videos).Before(videoPublishedAt(b, videos))
+		}, nil
+	case "duration":
+		return func(a, b *youtube.PlaylistItem) bool {
+			return videoDuration(a, videos) < videoDuration(b, videos)
+		}, nil
+	case "views":
+		return func(a, b *youtube.PlaylistItem) bool {
+			return videoViews(a, videos) < videoViews(b, videos)
+		}, nil
+	case "channel":
+		return func(a, b *youtube.PlaylistItem) bool {
+			return strings.ToLower(videoChannel(a, videos)) < strings.ToLower(videoChannel(b, videos))
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid sort field %q (valid: %s)", field, strings.Join(SortFields, ", "))
+	}
+}
+
+func videoPublishedAt(item *youtube.PlaylistItem, videos map[string]*youtube.Video) time.Time {
+	published := item.ContentDetails.VideoPublishedAt
+	if video, ok := videos[item.ContentDetails.VideoId]; ok && published == "" {
+		published = video.Snippet.PublishedAt
+	}
+	t, _ := time.Parse(time.RFC3339, published)
+	return t
+}
+
+func videoDuration(item *youtube.PlaylistItem, videos map[string]*youtube.Video) time.Duration {
+	video, ok := videos[item.ContentDetails.VideoId]
+	if !ok || video.ContentDetails == nil {
+		return 0
+	}
+	d, _ := ParseDuration(video.ContentDetails.Duration)
+	return d
+}
+
+func videoViews(item *youtube.PlaylistItem, videos map[string]*youtube.Video) uint64 {
+	video, ok := videos[item.ContentDetails.VideoId]
+	if !ok || video.Statistics == nil {
+		return 0
+	}
+	return video.Statistics.ViewCount
+}
+
+// videoChannel returns the channel that published the video, which is not
+// the playlist owner reported in the item's ChannelTitle.
+func videoChannel(item *youtube.PlaylistItem, videos map[string]*youtube.Video) string {
+	if video, ok := videos[item.ContentDetails.VideoId]; ok {
+		return video.Snippet.ChannelTitle
+	}
+	return item.Snippet.VideoOwnerChannelTitle
+}
+
+// PlanMoves computes a minimal sequence of moves turning the current order of
+// a playlist into the target order. Items on a longest increasing subsequence
+// of the current order (relative to the target) stay in place; every other
+// item is moved right after its predecessor in the target order. Moves must be
+// applied in the returned order.
+func PlanMoves(current, target []*youtube.PlaylistItem) []Move {
+	targetIndex := make(map[*youtube.PlaylistItem]int, len(target))
+	for i, item := range target {
+		targetIndex[item] = i
+	}
+
+	ranks := make([]int, len(current))
+	for i, item := range current {
+		ranks[i] = targetIndex[item]
+	}
+
+	stay := make(map[*youtube.PlaylistItem]bool)
+	for _, i := range longestIncreasing(ranks) {
+		stay[current[i]] = true
+	}
+
+	order := append([]*youtube.PlaylistItem(nil), current...)
+	var moves []Move
+	for t, item := range target {
+		if stay[item] {
+			continue
+		}
+
+		order = removeItem(order, item)
+		position := 0
+		if t > 0 {
+			position = indexOf(order, target[t-1]) + 1
+		}
+		order = append(order[:position], append([]*youtube.PlaylistItem{item}, order[position:]...)...)
+		moves = append(moves, Move{Item: item, Position: int64(position)})
+	}
+
+	return moves
+}
+
+// longestIncreasing returns the indexes of a longest strictly increasing
+// subsequence of values.
+func longestIncreasing(values []int) []int {
+	// tails[k] is the index of the smallest tail of an increasing
+	// subsequence of length k+1; prev links each index to its predecessor.
+	var tails []int
+	prev := make([]int, len(values))
+	for i, v := range values {
+		k := sort.Search(len(tails), func(k int) bool { return values[tails[k]] >= v })
+		if k > 0 {
+			prev[i] = tails[k-1]
+		} else {
+			prev[i] = -1
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+
+	result := make([]int, len(tails))
+	if len(tails) == 0 {
+		return result
+	}
+	for k, i := len(tails)-1, tails[len(tails)-1]; k >= 0; k, i = k-1, prev[i] {
+		result[k] = i
+	}
+	return result
+}
+
+func removeItem(items []*youtube.PlaylistItem, item *youtube.PlaylistItem) []*youtube.PlaylistItem {
+	i := indexOf(items, item)
+	return append(items[:i], items[i+1:]...)
+}
+
+func indexOf(items []*youtube.PlaylistItem, item *youtube.PlaylistItem) int {
+	for i, candidate := range items {
+		if candidate == item {
+			return i
+		}
+	}
+	return -1
+}
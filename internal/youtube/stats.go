@@ -0,0 +1,124 @@
+package youtube
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"google.golang.org/api/youtube/v3"
+)
+
+// ChannelCount is the number of videos from one channel.
+type ChannelCount struct {
+	Channel string `json:"channel"`
+	Videos  int    `json:"videos"`
+}
+
+// YearCount is the number of videos published in one year.
+type YearCount struct {
+	Year   int `json:"year"`
+	Videos int `json:"videos"`
+}
+
+// VideoSummary identifies a video and its duration.
+type VideoSummary struct {
+	VideoID         string `json:"video_id"`
+	Title           string `json:"title"`
+	DurationSeconds int64  `json:"duration_seconds"`
+}
+
+// PlaylistStats summarises the videos of a playlist. Deleted and private
+// videos are only counted in Unavailable.
+type PlaylistStats struct {
+	Items          int            `json:"items"`
+	Unavailable    int            `json:"unavailable"`
+	TotalSeconds   int64          `json:"total_seconds"`
+	AverageSeconds int64          `json:"average_seconds"`
+	TotalViews     uint64         `json:"total_views"`
+	TopChannels    []ChannelCount `json:"top_channels"`
+	Years          []YearCount    `json:"years"`
+	Longest        *VideoSummary  `json:"longest,omitempty"`
+	Shortest       *VideoSummary  `json:"shortest,omitempty"`
+
+	channels map[string]int
+	years    map[int]int
+	timed    int
+}
+
+// Add counts a playlist item and its video details, which are nil for
+// deleted or private videos.
+func (s *PlaylistStats) Add(item *youtube.PlaylistItem, video *youtube.Video) {
+	s.Items++
+	if video == nil {
+		s.Unavailable++
+		return
+	}
+	if s.channels == nil {
+		s.channels = make(map[string]int)
+		s.years = make(map[int]int)
+	}
+
+	if video.Snippet != nil {
+		s.channels[video.Snippet.ChannelTitle]++
+		if published, err := time.Parse(time.RFC3339, video.Snippet.PublishedAt); err == nil {
+			s.years[published.Year()]++
+		}
+	}
+
+	if video.Statistics != nil {
+		s.TotalViews += video.Statistics.ViewCount
+	}
+
+	if video.ContentDetails == nil {
+		return
+	}
+	duration, err := ParseDuration(video.ContentDetails.Duration)
+	if err != nil {
+		return
+	}
+
+	seconds := int64(duration.Seconds())
+	s.TotalSeconds += seconds
+	s.timed++
+
+	summary := &VideoSummary{VideoID: video.Id, DurationSeconds: seconds}
+	if video.Snippet != nil {
+		summary.Title = video.Snippet.Title
+	}
+	if s.Longest == nil || seconds > s.Longest.DurationSeconds {
+		s.Longest = summary
+	}
+	if s.Shortest == nil || seconds < s.Shortest.DurationSeconds {
+		s.Shortest = summary
+	}
+}
+
+// Finish computes the average, keeps the top channels (all of them if top
+// <= 0) and sorts the year histogram. Call it once all items are added.
+func (s *PlaylistStats) Finish(top int) {
+	if s.timed > 0 {
+		s.AverageSeconds = s.TotalSeconds / int64(s.timed)
+	}
+
+	s.TopChannels = make([]ChannelCount, 0, len(s.channels))
+	for channel, videos := range s.channels {
+		s.TopChannels = append(s.TopChannels, ChannelCount{Channel: channel, Videos: videos})
+	}
+	slices.SortFunc(s.TopChannels, func(a, b ChannelCount) int {
+		if a.Videos != b.Videos {
+			return cmp.Compare(b.Videos, a.Videos)
+		}
+		return cmp.Compare(a.Channel, b.Channel)
+	})
+	if top > 0 && len(s.TopChannels) > top {
+		s.TopChannels = s.TopChannels[:top]
+	}
+
+	s.Years = make([]YearCount, 0, len(s.years))
+	for year, videos := range s.years {
+		s.Years = append(s.Years, YearCount{Year: year, Videos: videos})
+	}
+	slices.SortFunc(s.Years, func(a, b YearCount) int {
+		return cmp.Compare(a.Year, b.Year)
+	})
+}
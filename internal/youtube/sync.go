@@ -0,0 +1,60 @@
+package youtube
+
+import (
+	"google.golang.org/api/youtube/v3"
+)
+
+// Insertion adds a video at a 0-based position.
+type Insertion struct {
+	VideoID  string
+	Position int64
+}
+
+// SyncPlan lists the changes turning a playlist into a desired list of
+// videos. Apply removals first, then moves, then insertions, each in order.
+type SyncPlan struct {
+	Removals   []*youtube.PlaylistItem
+	Moves      []Move
+	Insertions []Insertion
+}
+
+// Empty reports whether the playlist already matches.
+func (p SyncPlan) Empty() bool {
+	return len(p.Removals) == 0 && len(p.Moves) == 0 && len(p.Insertions) == 0
+}
+
+// PlanSync computes the changes that make current hold exactly the videos in
+// desired, in that order. A video listed several times in desired is kept
+// that many times; existing items are reused before new ones are added.
+func PlanSync(current []*youtube.PlaylistItem, desired []string) SyncPlan {
+	available := make(map[string][]*youtube.PlaylistItem)
+	for _, item := range current {
+		id := item.ContentDetails.VideoId
+		available[id] = append(available[id], item)
+	}
+
+	var plan SyncPlan
+	matched := make(map[*youtube.PlaylistItem]bool)
+	target := make([]*youtube.PlaylistItem, 0, len(desired))
+	for i, id := range desired {
+		if items := available[id]; len(items) > 0 {
+			available[id] = items[1:]
+			matched[items[0]] = true
+			target = append(target, items[0])
+			continue
+		}
+		plan.Insertions = append(plan.Insertions, Insertion{VideoID: id, Position: int64(i)})
+	}
+
+	kept := make([]*youtube.PlaylistItem, 0, len(target))
+	for _, item := range current {
+		if matched[item] {
+			kept = append(kept, item)
+		} else {
+			plan.Removals = append(plan.Removals, item)
+		}
+	}
+
+	plan.Moves = PlanMoves(kept, target)
+	return plan
+}
@@ -3,6 +3,10 @@ package youtube
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
 
 	"google.golang.org/api/youtube/v3"
 )
@@ -32,19 +36,211 @@ func (vs *VideoService) Get(ctx context.Context, videoID string) (*youtube.Video
 	return response.Items[0], nil
 }
 
-// Search searches for videos.
-func (vs *VideoService) Search(ctx context.Context, query string, limit int) ([]*youtube.SearchResult, error) {
-	call := vs.service.Search.List([]string{"snippet"}).
-		Q(query).
-		Type("video").
-		MaxResults(int64(limit))
+// GetDetails retrieves snippet, contentDetails, statistics and status for many videos,
+// batching up to 50 IDs per call. Videos that do not exist or are not visible
+// are missing from the returned map.
+func (vs *VideoService) GetDetails(ctx context.Context, videoIDs []string) (map[string]*youtube.Video, error) {
+	videos := make(map[string]*youtube.Video, len(videoIDs))
+	for start := 0; start < len(videoIDs); start += maxPageSize {
+		end := min(start+maxPageSize, len(videoIDs))
 
-	response, err := call.Do()
+		call := vs.service.Videos.List([]string{"snippet", "contentDetails", "statistics", "status"}).
+			Id(videoIDs[start:end]...).
+			MaxResults(maxPageSize).
+			Context(ctx)
+
+		response, err := call.Do()
+		if err != nil {
+			return nil, fmt.Errorf("error fetching videos: %w", err)
+		}
+
+		for _, video := range response.Items {
+			videos[video.Id] = video
+		}
+	}
+
+	return videos, nil
+}
+
+// GetMany retrieves many videos in input order, batching up to 50 IDs per
+// call. IDs that do not exist or are not visible are returned in missing.
+func (vs *VideoService) GetMany(ctx context.Context, videoIDs []string) (videos []*youtube.Video, missing []string, err error) {
+	found, err := vs.GetDetails(ctx, videoIDs)
 	if err != nil {
-		return nil, fmt.Errorf("error searching videos: %w", err)
+		return nil, nil, err
+	}
+
+	videos = make([]*youtube.Video, 0, len(videoIDs))
+	for _, id := range videoIDs {
+		if video, ok := found[id]; ok {
+			videos = append(videos, video)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	return videos, missing, nil
+}
+
+// Search filter values accepted by the API.
+var (
+	SearchTypes       = []string{"video", "channel", "playlist"}
+	SearchOrders      = []string{"date", "rating", "relevance", "title", "videoCount", "viewCount"}
+	SearchDurations   = []string{"any", "short", "medium", "long"}
+	SearchDefinitions = []string{"any", "high", "standard"}
+	SearchCaptions    = []string{"any", "closedCaption", "none"}
+	SearchSafeSearch  = []string{"moderate", "none", "strict"}
+	SearchEventTypes  = []string{"completed", "live", "upcoming"}
+)
+
+// regionCodePattern matches ISO 3166-1 alpha-2 codes and languagePattern
+// matches ISO 639-1 codes, optionally followed by a script or region subtag.
+var (
+	regionCodePattern = regexp.MustCompile(`^[A-Za-z]{2}$`)
+	languagePattern   = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,4})?$`)
+)
+
+// ValidateRegionCode checks the value of a --region flag, which is either
+// empty or a two-letter country code.
+func ValidateRegionCode(region string) error {
+	if region != "" && !regionCodePattern.MatchString(region) {
+		return fmt.Errorf("invalid --region %q: expected a two-letter country code", region)
+	}
+	return nil
+}
+
+// SearchOptions filters a search. Zero values leave a filter unset.
+type SearchOptions struct {
+	// Type is the kind of result: video (the default), channel or playlist.
+	Type              string
+	ChannelID         string
+	PublishedAfter    time.Time
+	PublishedBefore   time.Time
+	Order             string
+	RegionCode        string
+	RelevanceLanguage string
+	SafeSearch        string
+	// Duration, Definition, Caption and EventType only apply to videos.
+	Duration   string
+	Definition string
+	Caption    string
+	EventType  string
+}
+
+// Validate checks the options before a request is sent, so that typos are
+// reported without spending quota.
+func (o SearchOptions) Validate() error {
+	checks := []struct {
+		flag, value string
+		valid       []string
+	}{
+		{"type", o.Type, SearchTypes},
+		{"order", o.Order, SearchOrders},
+		{"duration", o.Duration, SearchDurations},
+		{"definition", o.Definition, SearchDefinitions},
+		{"caption", o.Caption, SearchCaptions},
+		{"safe-search", o.SafeSearch, SearchSafeSearch},
+		{"event-type", o.EventType, SearchEventTypes},
+	}
+	for _, check := range checks {
+		if check.value != "" && !slices.Contains(check.valid, check.value) {
+			return fmt.Errorf("invalid --%s %q (valid: %s)", check.flag, check.value, strings.Join(check.valid, ", "))
+		}
+	}
+
+	if o.Type != "" && o.Type != "video" {
+		videoOnly := []struct{ flag, value string }{
+			{"duration", o.Duration},
+			{"definition", o.Definition},
+			{"caption", o.Caption},
+			{"event-type", o.EventType},
+		}
+		for _, filter := range videoOnly {
+			if filter.value != "" {
+				return fmt.Errorf("--%s only applies to --type video", filter.flag)
+			}
+		}
+	}
+
+	if err := ValidateRegionCode(o.RegionCode); err != nil {
+		return err
+	}
+	if o.RelevanceLanguage != "" && !languagePattern.MatchString(o.RelevanceLanguage) {
+		return fmt.Errorf("invalid --language %q: expected a language code such as en or zh-Hans", o.RelevanceLanguage)
 	}
+	if !o.PublishedAfter.IsZero() && !o.PublishedBefore.IsZero() && !o.PublishedAfter.Before(o.PublishedBefore) {
+		return fmt.Errorf("--published-after must be before --published-before")
+	}
+
+	return nil
+}
+
+// Search searches for videos, channels or playlists, up to limit (no limit if
+// limit <= 0).
+func (vs *VideoService) Search(ctx context.Context, query string, opts SearchOptions, limit int) ([]*youtube.SearchResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	searchType := opts.Type
+	if searchType == "" {
+		searchType = "video"
+	}
+
+	return collectPages(limit, func(pageToken string, pageSize int64) ([]*youtube.SearchResult, string, error) {
+		call := vs.service.Search.List([]string{"snippet"}).
+			Type(searchType).
+			MaxResults(pageSize).
+			Context(ctx)
+
+		if query != "" {
+			call = call.Q(query)
+		}
+		if opts.ChannelID != "" {
+			call = call.ChannelId(opts.ChannelID)
+		}
+		if !opts.PublishedAfter.IsZero() {
+			call = call.PublishedAfter(opts.PublishedAfter.UTC().Format(time.RFC3339))
+		}
+		if !opts.PublishedBefore.IsZero() {
+			call = call.PublishedBefore(opts.PublishedBefore.UTC().Format(time.RFC3339))
+		}
+		if opts.Order != "" {
+			call = call.Order(opts.Order)
+		}
+		if opts.RegionCode != "" {
+			call = call.RegionCode(strings.ToUpper(opts.RegionCode))
+		}
+		if opts.RelevanceLanguage != "" {
+			call = call.RelevanceLanguage(opts.RelevanceLanguage)
+		}
+		if opts.SafeSearch != "" {
+			call = call.SafeSearch(opts.SafeSearch)
+		}
+		if opts.Duration != "" {
+			call = call.VideoDuration(opts.Duration)
+		}
+		if opts.Definition != "" {
+			call = call.VideoDefinition(opts.Definition)
+		}
+		if opts.Caption != "" {
+			call = call.VideoCaption(opts.Caption)
+		}
+		if opts.EventType != "" {
+			call = call.EventType(opts.EventType)
+		}
+
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
 
-	return response.Items, nil
+		response, err := call.Do()
+		if err != nil {
+			return nil, "", fmt.Errorf("error searching: %w", err)
+		}
+
+		return response.Items, response.NextPageToken, nil
+	})
 }
 
 // PrintVideo prints video information to stdout.
@@ -75,20 +271,35 @@ func PrintVideo(video *youtube.Video) {
 // PrintSearchResults prints search results to stdout.
 func PrintSearchResults(results []*youtube.SearchResult) {
 	if len(results) == 0 {
-		fmt.Println("No videos found.")
+		fmt.Println("No results found.")
 		return
 	}
 
-	fmt.Printf("✅ Found %d video(s):\n\n", len(results))
+	fmt.Printf("✅ Found %d result(s):\n\n", len(results))
 	for idx, item := range results {
 		fmt.Printf("%d. %s\n", idx+1, item.Snippet.Title)
-		fmt.Printf("   Video ID: %s\n", item.Id.VideoId)
-		fmt.Printf("   Channel: %s\n", item.Snippet.ChannelTitle)
+		var link string
+		switch item.Id.Kind {
+		case "youtube#channel":
+			fmt.Printf("   Channel ID: %s\n", item.Id.ChannelId)
+			link = "https://www.youtube.com/channel/" + item.Id.ChannelId
+		case "youtube#playlist":
+			fmt.Printf("   Playlist ID: %s\n", item.Id.PlaylistId)
+			fmt.Printf("   Channel: %s\n", item.Snippet.ChannelTitle)
+			link = "https://www.youtube.com/playlist?list=" + item.Id.PlaylistId
+		default:
+			fmt.Printf("   Video ID: %s\n", item.Id.VideoId)
+			fmt.Printf("   Channel: %s\n", item.Snippet.ChannelTitle)
+			if item.Snippet.LiveBroadcastContent != "" && item.Snippet.LiveBroadcastContent != "none" {
+				fmt.Printf("   Live: %s\n", item.Snippet.LiveBroadcastContent)
+			}
+			link = "https://www.youtube.com/watch?v=" + item.Id.VideoId
+		}
 		desc := item.Snippet.Description
 		if len(desc) > 100 {
 			desc = desc[:100] + "..."
 		}
 		fmt.Printf("   Description: %s\n", desc)
-		fmt.Printf("   Link: https://www.youtube.com/watch?v=%s\n\n", item.Id.VideoId)
+		fmt.Printf("   Link: %s\n\n", link)
 	}
 }
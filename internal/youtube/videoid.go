@@ -0,0 +1,53 @@
+package youtube
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// videoIDPattern matches a bare YouTube video ID.
+var videoIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+
+// ParseVideoID extracts a video ID from a bare ID or from a youtube.com,
+// youtu.be, shorts, embed or live URL.
+func ParseVideoID(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if videoIDPattern.MatchString(value) {
+		return value, nil
+	}
+
+	raw := value
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid video ID or URL: %q", value)
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	host = strings.TrimPrefix(host, "m.")
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+
+	var id string
+	switch host {
+	case "youtu.be":
+		id = segments[0]
+	case "youtube.com", "music.youtube.com", "youtube-nocookie.com":
+		switch segments[0] {
+		case "watch":
+			id = u.Query().Get("v")
+		case "shorts", "embed", "live", "v":
+			if len(segments) > 1 {
+				id = segments[1]
+			}
+		}
+	}
+
+	if !videoIDPattern.MatchString(id) {
+		return "", fmt.Errorf("invalid video ID or URL: %q", value)
+	}
+	return id, nil
+}